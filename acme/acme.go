@@ -0,0 +1,302 @@
+// Package acme solves ACME DNS-01 challenges, e.g. of Let's Encrypt, with the host records of Namecheap domains.
+//
+// The Solver adds the _acme-challenge TXT records to the existing host records and removes them
+// without touching the other records. Several challenges of the same zone can be presented concurrently,
+// e.g. for domain.com and *.domain.com, without losing updates.
+//
+// The Solver implements the challenge.Provider and challenge.ProviderTimeout interfaces of lego:
+//
+//	solver := acme.NewSolver(client, &acme.Options{
+//		PropagationTimeout: 10 * time.Minute,
+//		Resolver:           acme.NewNameserverResolver(acme.DefaultNameservers...),
+//	})
+//
+//	err := legoClient.Challenge.SetDNS01Provider(solver)
+package acme
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+const (
+	// DefaultTTL is the TTL of the challenge records, the lowest one Namecheap accepts
+	DefaultTTL = 60
+	// DefaultPollingInterval is the time between two lookups of the challenge record while waiting for its propagation
+	DefaultPollingInterval = 10 * time.Second
+	// DefaultMaxRetries is the number of times the records are re-read and written again after a concurrent modification
+	DefaultMaxRetries = 5
+)
+
+// challengeLabel is the label prefixed to the domain name of the challenge record
+const challengeLabel = "_acme-challenge"
+
+// DefaultNameservers are the authoritative nameservers of the domains using the Namecheap DNS
+var DefaultNameservers = []string{"dns1.registrar-servers.com:53", "dns2.registrar-servers.com:53"}
+
+// retryDelay is the base wait before re-reading the records after a concurrent modification
+var retryDelay = time.Second
+
+// TXTResolver looks up TXT records, it is implemented by *net.Resolver
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Options configures a Solver
+type Options struct {
+	// TTL is the TTL of the challenge records. Default value: DefaultTTL
+	TTL int
+	// PropagationTimeout is how long Present waits for Resolver to return the challenge record.
+	// Present doesn't wait when it is 0.
+	PropagationTimeout time.Duration
+	// PollingInterval is the time between two lookups of the challenge record. Default value: DefaultPollingInterval
+	PollingInterval time.Duration
+	// Resolver looks up the challenge record while waiting for its propagation. Default value: net.DefaultResolver
+	Resolver TXTResolver
+	// MaxRetries is the number of retries after a concurrent modification of the records. Default value: DefaultMaxRetries
+	MaxRetries int
+}
+
+// Solver presents and cleans up the DNS-01 challenge records of Namecheap domains
+type Solver struct {
+	client  *namecheap.Client
+	options Options
+
+	mu    sync.Mutex
+	zones map[string]*sync.Mutex
+}
+
+// NewSolver returns a solver changing the host records with the client, options may be nil
+func NewSolver(client *namecheap.Client, options *Options) *Solver {
+	s := &Solver{client: client, zones: map[string]*sync.Mutex{}}
+
+	if options != nil {
+		s.options = *options
+	}
+	if s.options.TTL == 0 {
+		s.options.TTL = DefaultTTL
+	}
+	if s.options.PollingInterval <= 0 {
+		s.options.PollingInterval = DefaultPollingInterval
+	}
+	if s.options.Resolver == nil {
+		s.options.Resolver = net.DefaultResolver
+	}
+	if s.options.MaxRetries == 0 {
+		s.options.MaxRetries = DefaultMaxRetries
+	}
+
+	return s
+}
+
+// NewNameserverResolver returns a resolver sending the queries to the nameservers, e.g. DefaultNameservers,
+// instead of the system resolver. The addresses include the port.
+func NewNameserverResolver(nameservers ...string) *net.Resolver {
+	var mu sync.Mutex
+	next := 0
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			mu.Lock()
+			address := nameservers[next%len(nameservers)]
+			next++
+			mu.Unlock()
+
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// ChallengeRecord returns the fully qualified name and the value of the TXT record of the challenge
+func ChallengeRecord(domain, keyAuth string) (fqdn, value string) {
+	domain = strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(domain), "*."), ".")
+	digest := sha256.Sum256([]byte(keyAuth))
+
+	return challengeLabel + "." + domain + ".", base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+// Present adds the TXT record of the challenge, keeping the other host records of the domain.
+// The record is added once when the same challenge is presented again.
+func (s *Solver) Present(domain, token, keyAuth string) error {
+	return s.PresentContext(context.Background(), domain, token, keyAuth)
+}
+
+// PresentContext is like Present but uses the provided context for the requests and the wait for the propagation
+func (s *Solver) PresentContext(ctx context.Context, domain, _, keyAuth string) error {
+	fqdn, value := ChallengeRecord(domain, keyAuth)
+
+	zone, host, err := splitChallengeDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	err = s.modifyRecords(ctx, zone, func(records []namecheap.DomainsDNSHostRecord) ([]namecheap.DomainsDNSHostRecord, error) {
+		if findChallengeRecord(records, host, value) >= 0 {
+			return records, nil
+		}
+
+		return append(records, namecheap.DomainsDNSHostRecord{
+			HostName:   namecheap.String(host),
+			RecordType: namecheap.String(namecheap.RecordTypeTXT),
+			Address:    namecheap.String(value),
+			TTL:        namecheap.Int(s.options.TTL),
+		}), nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to present the challenge of %s: %w", domain, err)
+	}
+
+	if s.options.PropagationTimeout > 0 {
+		return s.waitForPropagation(ctx, fqdn, value)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record of the challenge, keeping the other host records of the domain,
+// including the records of the other challenges of the same domain
+func (s *Solver) CleanUp(domain, token, keyAuth string) error {
+	return s.CleanUpContext(context.Background(), domain, token, keyAuth)
+}
+
+// CleanUpContext is like CleanUp but uses the provided context for the requests
+func (s *Solver) CleanUpContext(ctx context.Context, domain, _, keyAuth string) error {
+	_, value := ChallengeRecord(domain, keyAuth)
+
+	zone, host, err := splitChallengeDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	err = s.modifyRecords(ctx, zone, func(records []namecheap.DomainsDNSHostRecord) ([]namecheap.DomainsDNSHostRecord, error) {
+		for index := findChallengeRecord(records, host, value); index >= 0; index = findChallengeRecord(records, host, value) {
+			records = append(records[:index], records[index+1:]...)
+		}
+		return records, nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to clean up the challenge of %s: %w", domain, err)
+	}
+
+	return nil
+}
+
+// Timeout returns the propagation timeout and the polling interval, it lets ACME clients like lego
+// wait for the propagation long enough
+func (s *Solver) Timeout() (timeout, interval time.Duration) {
+	timeout = s.options.PropagationTimeout
+	if timeout <= 0 {
+		timeout = time.Minute
+	}
+	return timeout, s.options.PollingInterval
+}
+
+// modifyRecords changes the records of the zone with ModifyRecords. The changes of the zone are serialized
+// within the solver, and retried when the records were modified by somebody else in between.
+func (s *Solver) modifyRecords(ctx context.Context, zone string, modify func(records []namecheap.DomainsDNSHostRecord) ([]namecheap.DomainsDNSHostRecord, error)) error {
+	lock := s.zoneLock(zone)
+	lock.Lock()
+	defer lock.Unlock()
+
+	for retry := 0; ; retry++ {
+		_, err := s.client.DomainsDNS.ModifyRecordsContext(ctx, zone, modify)
+		if !errors.Is(err, namecheap.ErrZoneChanged) || retry >= s.options.MaxRetries {
+			return err
+		}
+
+		if err := sleep(ctx, time.Duration(retry+1)*retryDelay); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Solver) zoneLock(zone string) *sync.Mutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lock, ok := s.zones[zone]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.zones[zone] = lock
+	}
+	return lock
+}
+
+// waitForPropagation looks up the challenge record until the resolver returns its value
+func (s *Solver) waitForPropagation(ctx context.Context, fqdn, value string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.options.PropagationTimeout)
+	defer cancel()
+
+	var lastErr error
+	for {
+		values, err := s.options.Resolver.LookupTXT(ctx, fqdn)
+		if err == nil {
+			for _, v := range values {
+				if v == value {
+					return nil
+				}
+			}
+		}
+		lastErr = err
+
+		if err := sleep(ctx, s.options.PollingInterval); err != nil {
+			if lastErr != nil {
+				return fmt.Errorf("challenge record %s not propagated: %w", fqdn, lastErr)
+			}
+			return fmt.Errorf("challenge record %s not propagated: %w", fqdn, err)
+		}
+	}
+}
+
+// splitChallengeDomain returns the registered domain and the host name of the challenge record
+func splitChallengeDomain(domain string) (zone, host string, err error) {
+	domain = strings.TrimSuffix(strings.TrimPrefix(strings.ToLower(domain), "*."), ".")
+
+	parsed, err := namecheap.ParseDomain(domain)
+	if err != nil {
+		return "", "", err
+	}
+
+	host = challengeLabel
+	if parsed.TRD != "" {
+		host += "." + parsed.TRD
+	}
+
+	return parsed.SLD + "." + parsed.TLD, host, nil
+}
+
+// findChallengeRecord returns the index of the TXT record of the host with the value, or -1
+func findChallengeRecord(records []namecheap.DomainsDNSHostRecord, host, value string) int {
+	for i, record := range records {
+		if record.HostName == nil || record.RecordType == nil || record.Address == nil {
+			continue
+		}
+		if strings.EqualFold(*record.HostName, host) && strings.EqualFold(*record.RecordType, namecheap.RecordTypeTXT) && *record.Address == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,201 @@
+package acme
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheaptest"
+)
+
+type txtResolverFunc func(ctx context.Context, name string) ([]string, error)
+
+func (f txtResolverFunc) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return f(ctx, name)
+}
+
+func setupServer(t *testing.T) *namecheaptest.Server {
+	t.Helper()
+
+	server := namecheaptest.NewServer()
+	t.Cleanup(server.Close)
+
+	server.AddDomain(namecheaptest.Domain{
+		Name: "domain.co.uk",
+		Hosts: []namecheaptest.Host{
+			{Name: "@", Type: "A", Address: "10.0.0.1"},
+			{Name: "@", Type: "TXT", Address: "v=spf1 -all"},
+		},
+	})
+
+	return server
+}
+
+func txtRecords(server *namecheaptest.Server) map[string][]string {
+	domain, _ := server.Domain("domain.co.uk")
+
+	records := map[string][]string{}
+	for _, host := range domain.Hosts {
+		if host.Type == "TXT" {
+			records[host.Name] = append(records[host.Name], host.Address)
+		}
+	}
+	return records
+}
+
+func TestChallengeRecord(t *testing.T) {
+	fqdn, value := ChallengeRecord("*.Domain.co.uk", "token.thumbprint")
+
+	assert.Equal(t, "_acme-challenge.domain.co.uk.", fqdn)
+	assert.Equal(t, "61rBZ_4knHblO0MNoxFsXZ_eTFUHum0B6IVRbhvUn5I", value)
+}
+
+func TestSolver(t *testing.T) {
+	_, value1 := ChallengeRecord("domain.co.uk", "key1")
+	_, value2 := ChallengeRecord("domain.co.uk", "key2")
+
+	t.Run("present_and_clean_up", func(t *testing.T) {
+		server := setupServer(t)
+		solver := NewSolver(server.Client(), nil)
+
+		err := solver.Present("www.domain.co.uk", "token", "key1")
+		if err != nil {
+			t.Fatal("Unable to present", err)
+		}
+		err = solver.Present("www.domain.co.uk", "token", "key1")
+		assert.Nil(t, err)
+
+		assert.Equal(t, map[string][]string{"@": {"v=spf1 -all"}, "_acme-challenge.www": {value1}}, txtRecords(server))
+
+		domain, _ := server.Domain("domain.co.uk")
+		assert.Equal(t, 60, domain.Hosts[2].TTL)
+
+		err = solver.CleanUp("www.domain.co.uk", "token", "key1")
+		assert.Nil(t, err)
+		err = solver.CleanUp("www.domain.co.uk", "token", "key1")
+		assert.Nil(t, err)
+
+		assert.Equal(t, map[string][]string{"@": {"v=spf1 -all"}}, txtRecords(server))
+		domain, _ = server.Domain("domain.co.uk")
+		assert.Len(t, domain.Hosts, 2)
+	})
+
+	t.Run("concurrent_challenges", func(t *testing.T) {
+		server := setupServer(t)
+		solver := NewSolver(server.Client(), nil)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i, domain := range []string{"domain.co.uk", "*.domain.co.uk"} {
+			wg.Add(1)
+			go func(i int, domain string) {
+				defer wg.Done()
+				errs[i] = solver.Present(domain, "token", []string{"key1", "key2"}[i])
+			}(i, domain)
+		}
+		wg.Wait()
+
+		assert.Nil(t, errs[0])
+		assert.Nil(t, errs[1])
+		assert.ElementsMatch(t, []string{value1, value2}, txtRecords(server)["_acme-challenge"])
+
+		err := solver.CleanUp("*.domain.co.uk", "token", "key2")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{value1}, txtRecords(server)["_acme-challenge"])
+	})
+
+	t.Run("concurrent_modification", func(t *testing.T) {
+		retryDelay = time.Millisecond
+		defer func() { retryDelay = time.Second }()
+
+		server := setupServer(t)
+
+		var mu sync.Mutex
+		getHosts := 0
+		server.AddHook(func(command string, _ url.Values) *namecheaptest.Fault {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if command != "namecheap.domains.dns.getHosts" {
+				return nil
+			}
+			getHosts++
+			if getHosts == 2 {
+				domain, _ := server.Domain("domain.co.uk")
+				domain.Hosts = append(domain.Hosts, namecheaptest.Host{Name: "other", Type: "TXT", Address: "written by someone else"})
+				server.AddDomain(domain)
+			}
+			return nil
+		})
+
+		err := NewSolver(server.Client(), nil).Present("domain.co.uk", "token", "key1")
+		if err != nil {
+			t.Fatal("Unable to present", err)
+		}
+
+		assert.Equal(t, map[string][]string{
+			"@":               {"v=spf1 -all"},
+			"other":           {"written by someone else"},
+			"_acme-challenge": {value1},
+		}, txtRecords(server))
+	})
+
+	t.Run("wait_for_propagation", func(t *testing.T) {
+		server := setupServer(t)
+
+		lookups := 0
+		solver := NewSolver(server.Client(), &Options{
+			PropagationTimeout: time.Second,
+			PollingInterval:    time.Millisecond,
+			Resolver: txtResolverFunc(func(ctx context.Context, name string) ([]string, error) {
+				assert.Equal(t, "_acme-challenge.domain.co.uk.", name)
+				lookups++
+				if lookups < 3 {
+					return nil, errors.New("no such host")
+				}
+				return []string{"other", value1}, nil
+			}),
+		})
+
+		err := solver.Present("domain.co.uk", "token", "key1")
+		assert.Nil(t, err)
+		assert.Equal(t, 3, lookups)
+
+		timeout, interval := solver.Timeout()
+		assert.Equal(t, time.Second, timeout)
+		assert.Equal(t, time.Millisecond, interval)
+	})
+
+	t.Run("propagation_timeout", func(t *testing.T) {
+		server := setupServer(t)
+
+		solver := NewSolver(server.Client(), &Options{
+			PropagationTimeout: 20 * time.Millisecond,
+			PollingInterval:    time.Millisecond,
+			Resolver: txtResolverFunc(func(ctx context.Context, name string) ([]string, error) {
+				return []string{"stale"}, nil
+			}),
+		})
+
+		err := solver.Present("domain.co.uk", "token", "key1")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "challenge record _acme-challenge.domain.co.uk. not propagated")
+	})
+
+	t.Run("api_error", func(t *testing.T) {
+		server := setupServer(t)
+
+		err := NewSolver(server.Client(), nil).Present("missing.com", "token", "key1")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unable to present the challenge of missing.com")
+
+		var apiErr *namecheap.APIError
+		assert.True(t, errors.As(err, &apiErr))
+	})
+}
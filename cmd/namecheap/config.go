@@ -0,0 +1,121 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+const defaultConfigPathHelp = "$XDG_CONFIG_HOME/namecheap/config.yaml"
+
+// config holds the credentials of the API, it is read from a YAML file:
+//
+//	user_name: john
+//	api_user: john
+//	api_key: 0123456789abcdef
+//	client_ip: 203.0.113.7
+//	sandbox: false
+type config struct {
+	UserName string `yaml:"user_name"`
+	APIUser  string `yaml:"api_user"`
+	APIKey   string `yaml:"api_key"`
+	ClientIP string `yaml:"client_ip"`
+	Sandbox  bool   `yaml:"sandbox"`
+	// BaseURL overrides the API endpoint, e.g. for a local stand-in server
+	BaseURL string `yaml:"base_url"`
+}
+
+// loadConfig reads the config file and applies the environment variables on top of it.
+// A missing file is an error only when its path was set explicitly.
+func loadConfig(path string, getenv func(string) string) (*config, error) {
+	explicit := path != ""
+	if !explicit {
+		path = getenv("NAMECHEAP_CONFIG")
+		explicit = path != ""
+	}
+	if !explicit {
+		if dir, err := os.UserConfigDir(); err == nil {
+			path = filepath.Join(dir, "namecheap", "config.yaml")
+		}
+	}
+
+	cfg := &config{}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := yaml.Unmarshal(data, cfg); err != nil {
+				return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+			}
+		case !explicit && errors.Is(err, fs.ErrNotExist):
+		default:
+			return nil, err
+		}
+	}
+
+	env := map[string]*string{
+		"NAMECHEAP_USER_NAME": &cfg.UserName,
+		"NAMECHEAP_API_USER":  &cfg.APIUser,
+		"NAMECHEAP_API_KEY":   &cfg.APIKey,
+		"NAMECHEAP_CLIENT_IP": &cfg.ClientIP,
+		"NAMECHEAP_API_URL":   &cfg.BaseURL,
+	}
+	for name, field := range env {
+		if value := getenv(name); value != "" {
+			*field = value
+		}
+	}
+
+	if value := getenv("NAMECHEAP_SANDBOX"); value != "" {
+		sandbox, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NAMECHEAP_SANDBOX %q: %w", value, err)
+		}
+		cfg.Sandbox = sandbox
+	}
+
+	if cfg.UserName == "" {
+		cfg.UserName = cfg.APIUser
+	}
+
+	return cfg, nil
+}
+
+func (cfg *config) validate() error {
+	switch {
+	case cfg.APIUser == "":
+		return fmt.Errorf("api_user is required, set it in the config file or NAMECHEAP_API_USER")
+	case cfg.APIKey == "":
+		return fmt.Errorf("api_key is required, set it in the config file or NAMECHEAP_API_KEY")
+	case cfg.ClientIP == "":
+		return fmt.Errorf("client_ip is required, set it in the config file or NAMECHEAP_CLIENT_IP")
+	}
+	return nil
+}
+
+func (cfg *config) newClient() (*namecheap.Client, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	var opts []namecheap.Option
+	if cfg.BaseURL != "" {
+		opts = append(opts, namecheap.WithBaseURL(cfg.BaseURL))
+	}
+
+	return namecheap.NewClient(&namecheap.ClientOptions{
+		UserName:   cfg.UserName,
+		ApiUser:    cfg.APIUser,
+		ApiKey:     cfg.APIKey,
+		ClientIp:   cfg.ClientIP,
+		UseSandbox: cfg.Sandbox,
+	}, opts...), nil
+}
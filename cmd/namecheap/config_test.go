@@ -0,0 +1,147 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadConfig(t *testing.T) {
+	writeConfig := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	getenv := func(env map[string]string) func(string) string {
+		return func(name string) string { return env[name] }
+	}
+
+	t.Run("file", func(t *testing.T) {
+		path := writeConfig(t, "user_name: john\napi_user: john-api\napi_key: key\nclient_ip: 203.0.113.7\nsandbox: true\n")
+
+		cfg, err := loadConfig(path, getenv(nil))
+
+		assert.Nil(t, err)
+		assert.Equal(t, &config{UserName: "john", APIUser: "john-api", APIKey: "key", ClientIP: "203.0.113.7", Sandbox: true}, cfg)
+	})
+
+	t.Run("env_overrides_file", func(t *testing.T) {
+		path := writeConfig(t, "api_user: john\napi_key: key\nclient_ip: 203.0.113.7\nsandbox: true\n")
+
+		cfg, err := loadConfig("", getenv(map[string]string{
+			"NAMECHEAP_CONFIG":    path,
+			"NAMECHEAP_API_KEY":   "env-key",
+			"NAMECHEAP_CLIENT_IP": "198.51.100.1",
+			"NAMECHEAP_SANDBOX":   "false",
+		}))
+
+		assert.Nil(t, err)
+		assert.Equal(t, &config{UserName: "john", APIUser: "john", APIKey: "env-key", ClientIP: "198.51.100.1"}, cfg)
+	})
+
+	t.Run("flag_path_overrides_env_path", func(t *testing.T) {
+		path := writeConfig(t, "api_user: flag\n")
+
+		cfg, err := loadConfig(path, getenv(map[string]string{"NAMECHEAP_CONFIG": "/nonexistent/config.yaml"}))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "flag", cfg.APIUser)
+	})
+
+	t.Run("missing_explicit_file", func(t *testing.T) {
+		_, err := loadConfig(filepath.Join(t.TempDir(), "missing.yaml"), getenv(nil))
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid_file", func(t *testing.T) {
+		path := writeConfig(t, "api_user: [")
+
+		_, err := loadConfig(path, getenv(nil))
+
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "invalid config file")
+		}
+	})
+
+	t.Run("invalid_sandbox", func(t *testing.T) {
+		path := writeConfig(t, "")
+
+		_, err := loadConfig(path, getenv(map[string]string{"NAMECHEAP_SANDBOX": "maybe"}))
+
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "invalid NAMECHEAP_SANDBOX")
+		}
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := []struct {
+		name  string
+		cfg   config
+		error string
+	}{
+		{"valid", config{APIUser: "john", APIKey: "key", ClientIP: "203.0.113.7"}, ""},
+		{"api_user", config{APIKey: "key", ClientIP: "203.0.113.7"}, "api_user is required"},
+		{"api_key", config{APIUser: "john", ClientIP: "203.0.113.7"}, "api_key is required"},
+		{"client_ip", config{APIUser: "john", APIKey: "key"}, "client_ip is required"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.validate()
+
+			if tc.error == "" {
+				assert.Nil(t, err)
+			} else if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), tc.error)
+			}
+		})
+	}
+}
+
+func TestSandboxFlag(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  string
+		args    []string
+		sandbox bool
+	}{
+		{name: "flag", args: []string{"--sandbox"}, sandbox: true},
+		{name: "config", config: "sandbox: true", sandbox: true},
+		{name: "flag_false_overrides_config", config: "sandbox: true", args: []string{"--sandbox=false"}, sandbox: false},
+		{name: "flag_true_overrides_config", config: "sandbox: false", args: []string{"--sandbox=true"}, sandbox: true},
+		{name: "default", sandbox: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &cli{getenv: func(name string) string {
+				return map[string]string{
+					"NAMECHEAP_API_USER":  "john",
+					"NAMECHEAP_API_KEY":   "key",
+					"NAMECHEAP_CLIENT_IP": "203.0.113.7",
+				}[name]
+			}, configPath: filepath.Join(t.TempDir(), "config.yaml")}
+			if err := os.WriteFile(c.configPath, []byte(testCase.config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			fs := flag.NewFlagSet("namecheap", flag.ContinueOnError)
+			c.addGlobalFlags(fs)
+			if err := fs.Parse(testCase.args); err != nil {
+				t.Fatal(err)
+			}
+
+			client, err := c.apiClient()
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.sandbox, client.ClientOptions.UseSandbox)
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+	"github.com/namecheap/go-namecheap-sdk/v2/zonefile"
+)
+
+// recordFlags are the flags describing a single host record
+type recordFlags struct {
+	host    string
+	kind    string
+	address string
+	ttl     int
+	// mxPref is nil unless --mx-pref was passed, so that 0 can be set explicitly
+	mxPref *uint8
+}
+
+func (f *recordFlags) register(fs *flag.FlagSet, withSettings bool) {
+	fs.StringVar(&f.host, "host", "@", "host name of the record, @ for the domain itself")
+	fs.StringVar(&f.kind, "type", "", "record type, e.g. A, AAAA, CNAME, MX or TXT")
+	fs.StringVar(&f.address, "address", "", "address of the record")
+	if withSettings {
+		fs.IntVar(&f.ttl, "ttl", 0, "TTL of the record in seconds, the server default when 0")
+		fs.Func("mx-pref", "preference of an MX record between 0 and 255, the server default when not set", func(value string) error {
+			mxPref, err := strconv.ParseUint(value, 10, 8)
+			if err != nil {
+				return fmt.Errorf("it must be between 0 and 255")
+			}
+			f.mxPref = namecheap.UInt8(uint8(mxPref))
+			return nil
+		})
+	}
+}
+
+func (f *recordFlags) record() (namecheap.DomainsDNSHostRecord, error) {
+	switch {
+	case f.kind == "":
+		return namecheap.DomainsDNSHostRecord{}, fmt.Errorf("--type is required")
+	case f.address == "":
+		return namecheap.DomainsDNSHostRecord{}, fmt.Errorf("--address is required")
+	}
+
+	record := namecheap.DomainsDNSHostRecord{
+		HostName:   namecheap.String(f.host),
+		RecordType: namecheap.String(strings.ToUpper(f.kind)),
+		Address:    namecheap.String(f.address),
+	}
+	if f.ttl != 0 {
+		record.TTL = namecheap.Int(f.ttl)
+	}
+	record.MXPref = f.mxPref
+	return record, nil
+}
+
+// printPlan prints the changes of a zone plan, applied tells whether they were applied or only planned
+func (c *cli) printPlan(plan *namecheap.ZonePlan, applied bool) error {
+	if plan.IsEmpty() && c.output == outputTable {
+		_, _ = fmt.Fprintln(c.stderr, "No changes, the records are up to date.")
+		return nil
+	}
+
+	t := &table{header: []string{"CHANGE"}}
+	for _, change := range plan.Changes {
+		t.add(change.String())
+	}
+	if plan.EmailTypeBefore != plan.EmailTypeAfter {
+		t.add(fmt.Sprintf("~ EmailType %s => %s", plan.EmailTypeBefore, plan.EmailTypeAfter))
+	}
+
+	if err := c.print(plan, t); err != nil {
+		return err
+	}
+	if !applied && c.output == outputTable {
+		_, _ = fmt.Fprintln(c.stderr, "Dry run, nothing was changed.")
+	}
+	return nil
+}
+
+func init() {
+	var asZoneFile bool
+	register(&command{
+		path:    []string{"dns", "hosts", "get"},
+		args:    "DOMAIN",
+		summary: "List the host records of a domain",
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&asZoneFile, "zonefile", false, "print the records as a BIND zone file")
+		},
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsDNS.GetHostsContext(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainDNSGetHostsResult
+			if asZoneFile {
+				return zonefile.Export(c.stdout, result, nil)
+			}
+
+			t := &table{header: []string{"HOST", "TYPE", "ADDRESS", "TTL", "MXPREF"}}
+			if result != nil && result.Hosts != nil {
+				for _, host := range *result.Hosts {
+					mxPref := ""
+					if strings.EqualFold(str(host.Type), namecheap.RecordTypeMX) {
+						mxPref = integer(host.MXPref)
+					}
+					t.add(str(host.Name), str(host.Type), str(host.Address), integer(host.TTL), mxPref)
+				}
+			}
+			return c.print(result, t)
+		},
+	})
+
+	var file string
+	var dryRun bool
+	register(&command{
+		path:    []string{"dns", "hosts", "set"},
+		args:    "DOMAIN",
+		summary: "Replace the host records of a domain with the records of a zone file",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&file, "file", "", "BIND zone file with the records, - for the standard input")
+			fs.BoolVar(&dryRun, "dry-run", false, "print the changes without applying them")
+		},
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			if file == "" {
+				return fmt.Errorf("--file is required")
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			input := os.Stdin
+			if file != "-" {
+				if input, err = os.Open(file); err != nil {
+					return err
+				}
+				defer input.Close()
+			}
+
+			zone, err := zonefile.Parse(input, &zonefile.ParseOptions{Origin: args[0]})
+			if err != nil {
+				return err
+			}
+			for _, issue := range zone.Unsupported {
+				_, _ = fmt.Fprintf(c.stderr, "Skipped %s\n", issue)
+			}
+			for _, issue := range zone.Adjusted {
+				_, _ = fmt.Fprintf(c.stderr, "Adjusted %s\n", issue)
+			}
+
+			plan, err := client.DomainsDNS.PlanZoneContext(ctx, zone.NamecheapZone())
+			if err != nil {
+				return err
+			}
+			if !dryRun && !plan.IsEmpty() {
+				if err := client.DomainsDNS.ApplyPlanContext(ctx, plan); err != nil {
+					return err
+				}
+			}
+			return c.printPlan(plan, !dryRun)
+		},
+	})
+
+	var add recordFlags
+	register(&command{
+		path:    []string{"dns", "hosts", "add"},
+		args:    "DOMAIN",
+		summary: "Add a host record to a domain, keeping the other records",
+		flags: func(fs *flag.FlagSet) {
+			add.register(fs, true)
+		},
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			record, err := add.record()
+			if err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			plan, err := client.DomainsDNS.AddRecordContext(ctx, args[0], record)
+			if err != nil {
+				return err
+			}
+			return c.printPlan(plan, true)
+		},
+	})
+
+	var remove recordFlags
+	register(&command{
+		path:    []string{"dns", "hosts", "delete"},
+		args:    "DOMAIN",
+		summary: "Delete a host record of a domain, keeping the other records",
+		flags: func(fs *flag.FlagSet) {
+			remove.register(fs, false)
+		},
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			record, err := remove.record()
+			if err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			plan, err := client.DomainsDNS.DeleteRecordContext(ctx, args[0], record)
+			if err != nil {
+				return err
+			}
+			return c.printPlan(plan, true)
+		},
+	})
+
+	register(&command{
+		path:    []string{"dns", "set-custom"},
+		args:    "DOMAIN NAMESERVER...",
+		summary: "Use custom nameservers for a domain",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 2, -1); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsDNS.SetCustomContext(ctx, args[0], args[1:])
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainDNSSetCustomResult
+			t := &table{header: []string{"DOMAIN", "UPDATED"}}
+			if result != nil {
+				t.add(str(result.Domain), boolean(result.Updated))
+			}
+			return c.print(result, t)
+		},
+	})
+
+	register(&command{
+		path:    []string{"dns", "set-default"},
+		args:    "DOMAIN",
+		summary: "Use the Namecheap nameservers for a domain",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsDNS.SetDefaultContext(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainDNSSetDefaultResult
+			t := &table{header: []string{"DOMAIN", "UPDATED"}}
+			if result != nil {
+				t.add(str(result.Domain), boolean(result.Updated))
+			}
+			return c.print(result, t)
+		},
+	})
+
+	register(&command{
+		path:    []string{"dns", "email-forwarding", "get"},
+		args:    "DOMAIN",
+		summary: "List the email forwarding rules of a domain",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsDNS.GetEmailForwardingContext(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainDNSGetEmailForwardingResult
+			t := &table{header: []string{"MAILBOX", "FORWARD TO"}}
+			if result != nil && result.Forwards != nil {
+				for _, forward := range *result.Forwards {
+					t.add(str(forward.Mailbox), str(forward.ForwardTo))
+				}
+			}
+			return c.print(result, t)
+		},
+	})
+
+	register(&command{
+		path:    []string{"dns", "email-forwarding", "set"},
+		args:    "DOMAIN MAILBOX=ADDRESS...",
+		summary: "Replace the email forwarding rules of a domain",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 2, -1); err != nil {
+				return err
+			}
+
+			rules := make([]namecheap.EmailForwardingEntry, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				mailbox, forwardTo, ok := strings.Cut(arg, "=")
+				if !ok || mailbox == "" || forwardTo == "" {
+					return fmt.Errorf("invalid forwarding rule %q, use MAILBOX=ADDRESS", arg)
+				}
+				rules = append(rules, namecheap.EmailForwardingEntry{Mailbox: mailbox, ForwardTo: forwardTo})
+			}
+
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsDNS.SetEmailForwardingContext(ctx, args[0], rules)
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainDNSSetEmailForwardingResult
+			t := &table{header: []string{"DOMAIN", "SUCCESS"}}
+			if result != nil {
+				t.add(str(result.Domain), boolean(result.IsSuccess))
+			}
+			return c.print(result, t)
+		},
+	})
+}
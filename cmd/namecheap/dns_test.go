@@ -0,0 +1,221 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheaptest"
+)
+
+func TestDNSCommands(t *testing.T) {
+	setup := func(t *testing.T) (*namecheaptest.Server, map[string]string) {
+		server := namecheaptest.NewServer()
+		t.Cleanup(server.Close)
+
+		server.AddDomain(namecheaptest.Domain{
+			Name:      "domain.com",
+			EmailType: "MX",
+			Hosts: []namecheaptest.Host{
+				{Name: "@", Type: "A", Address: "10.0.0.1"},
+				{Name: "www", Type: "CNAME", Address: "domain.com"},
+				{Name: "@", Type: "MX", Address: "mail.domain.com", MXPref: 20},
+			},
+		})
+
+		return server, testEnv(t, server)
+	}
+	hosts := func(server *namecheaptest.Server) []string {
+		domain, _ := server.Domain("domain.com")
+		var result []string
+		for _, host := range domain.Hosts {
+			result = append(result, host.Name+" "+host.Type+" "+host.Address)
+		}
+		return result
+	}
+
+	t.Run("hosts_get", func(t *testing.T) {
+		_, env := setup(t)
+
+		stdout, stderr, code := runCLI(env, "dns", "hosts", "get", "domain.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Regexp(t, `HOST\s+TYPE\s+ADDRESS\s+TTL\s+MXPREF`, stdout)
+		assert.Regexp(t, `@\s+A\s+10\.0\.0\.1\s+1800\s*\n`, stdout)
+		assert.Regexp(t, `@\s+MX\s+mail\.domain\.com\.\s+1800\s+20`, stdout)
+	})
+
+	t.Run("hosts_get_zonefile", func(t *testing.T) {
+		_, env := setup(t)
+
+		stdout, stderr, code := runCLI(env, "dns", "hosts", "get", "domain.com", "--zonefile")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "$ORIGIN domain.com.")
+		assert.Regexp(t, `www\s+1800\s+IN\s+CNAME\s+domain\.com\.`, stdout)
+	})
+
+	t.Run("hosts_set", func(t *testing.T) {
+		server, env := setup(t)
+		zone := filepath.Join(t.TempDir(), "domain.com.zone")
+		content := "$ORIGIN domain.com.\n@ 1800 IN A 10.0.0.2\n@ 1800 IN MX 20 mail.domain.com.\n"
+		if err := os.WriteFile(zone, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		stdout, stderr, code := runCLI(env, "dns", "hosts", "set", "domain.com", "--file", zone, "--dry-run")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "- www 1800 CNAME")
+		assert.Contains(t, stderr, "Dry run")
+		assert.Len(t, hosts(server), 3)
+
+		stdout, stderr, code = runCLI(env, "dns", "hosts", "set", "domain.com", "--file", zone)
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "- www 1800 CNAME")
+		assert.Equal(t, []string{"@ A 10.0.0.2", "@ MX mail.domain.com."}, hosts(server))
+
+		_, stderr, code = runCLI(env, "dns", "hosts", "set", "domain.com", "--file", zone)
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stderr, "No changes")
+	})
+
+	t.Run("hosts_set_missing_file", func(t *testing.T) {
+		_, env := setup(t)
+
+		_, stderr, code := runCLI(env, "dns", "hosts", "set", "domain.com")
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, "--file is required")
+	})
+
+	t.Run("hosts_add_delete", func(t *testing.T) {
+		server, env := setup(t)
+
+		stdout, stderr, code := runCLI(env, "dns", "hosts", "add", "domain.com", "--host", "blog", "--type", "a", "--address", "10.0.0.3", "--ttl", "300")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "+ blog 300 A")
+		assert.Contains(t, hosts(server), "blog A 10.0.0.3")
+
+		stdout, stderr, code = runCLI(env, "dns", "hosts", "delete", "domain.com", "--host", "www", "--type", "CNAME", "--address", "domain.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "- www 1800 CNAME")
+		assert.NotContains(t, hosts(server), "www CNAME domain.com.")
+	})
+
+	t.Run("hosts_add_missing_type", func(t *testing.T) {
+		_, env := setup(t)
+
+		_, stderr, code := runCLI(env, "dns", "hosts", "add", "domain.com", "--address", "10.0.0.3")
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, "--type is required")
+	})
+
+	t.Run("set_custom_default", func(t *testing.T) {
+		server, env := setup(t)
+
+		_, stderr, code := runCLI(env, "dns", "set-custom", "domain.com", "ns1.other.net", "ns2.other.net")
+
+		assert.Equal(t, 0, code, stderr)
+		domain, _ := server.Domain("domain.com")
+		assert.Equal(t, []string{"ns1.other.net", "ns2.other.net"}, domain.Nameservers)
+
+		stdout, stderr, code := runCLI(env, "dns", "set-default", "domain.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Regexp(t, `domain\.com\s+true`, stdout)
+		domain, _ = server.Domain("domain.com")
+		assert.Empty(t, domain.Nameservers)
+	})
+
+	t.Run("email_forwarding_get", func(t *testing.T) {
+		var sent url.Values
+		env := mockEnv(t, `
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+				<Errors />
+				<RequestedCommand>namecheap.domains.dns.getEmailForwarding</RequestedCommand>
+				<CommandResponse Type="namecheap.domains.dns.getEmailForwarding">
+					<DomainDNSGetEmailForwardingResult Domain="domain.com">
+						<Forward mailbox="info">john@example.com</Forward>
+					</DomainDNSGetEmailForwardingResult>
+				</CommandResponse>
+			</ApiResponse>
+		`, &sent)
+
+		stdout, stderr, code := runCLI(env, "dns", "email-forwarding", "get", "domain.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, "namecheap.domains.dns.getEmailForwarding", sent.Get("Command"))
+		assert.Regexp(t, `info\s+john@example\.com`, stdout)
+	})
+
+	t.Run("email_forwarding_set", func(t *testing.T) {
+		var sent url.Values
+		env := mockEnv(t, `
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+				<Errors />
+				<RequestedCommand>namecheap.domains.dns.setEmailForwarding</RequestedCommand>
+				<CommandResponse Type="namecheap.domains.dns.setEmailForwarding">
+					<DomainDNSSetEmailForwardingResult Domain="domain.com" IsSuccess="true" />
+				</CommandResponse>
+			</ApiResponse>
+		`, &sent)
+
+		_, stderr, code := runCLI(env, "dns", "email-forwarding", "set", "domain.com", "info=john@example.com", "sales=jane@example.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, "namecheap.domains.dns.setEmailForwarding", sent.Get("Command"))
+		assert.Equal(t, "info", sent.Get("mailbox1"))
+		assert.Equal(t, "jane@example.com", sent.Get("ForwardTo2"))
+	})
+
+	t.Run("email_forwarding_set_invalid_rule", func(t *testing.T) {
+		_, env := setup(t)
+
+		_, stderr, code := runCLI(env, "dns", "email-forwarding", "set", "domain.com", "info")
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, `invalid forwarding rule "info"`)
+	})
+}
+
+func TestRecordFlags(t *testing.T) {
+	parse := func(args ...string) (namecheap.DomainsDNSHostRecord, error) {
+		var f recordFlags
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		f.register(fs, true)
+		if err := fs.Parse(append([]string{"--type", "MX", "--address", "mail.domain.com"}, args...)); err != nil {
+			return namecheap.DomainsDNSHostRecord{}, err
+		}
+		return f.record()
+	}
+
+	record, err := parse("--mx-pref", "0")
+	assert.Nil(t, err)
+	if assert.NotNil(t, record.MXPref) {
+		assert.Equal(t, uint8(0), *record.MXPref)
+	}
+
+	record, err = parse()
+	assert.Nil(t, err)
+	assert.Nil(t, record.MXPref)
+
+	_, err = parse("--mx-pref", "256")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "it must be between 0 and 255")
+	}
+}
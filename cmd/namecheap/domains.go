@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func init() {
+	var listType, search, sortBy string
+	register(&command{
+		path:    []string{"domains", "list"},
+		summary: "List the domains of the account",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&listType, "type", "ALL", "domains to list: ALL, EXPIRING or EXPIRED")
+			fs.StringVar(&search, "search", "", "keyword to look for in the domain names")
+			fs.StringVar(&sortBy, "sort", "", "sort order: NAME, NAME_DESC, EXPIREDATE, EXPIREDATE_DESC, CREATEDATE or CREATEDATE_DESC")
+		},
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 0, 0); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			listArgs := &namecheap.DomainsGetListArgs{ListType: namecheap.String(strings.ToUpper(listType))}
+			if search != "" {
+				listArgs.SearchTerm = namecheap.String(search)
+			}
+			if sortBy != "" {
+				listArgs.SortBy = namecheap.String(strings.ToUpper(sortBy))
+			}
+
+			domains, err := client.Domains.NewGetListPager(listArgs).All(ctx)
+			if err != nil {
+				return err
+			}
+
+			t := &table{header: []string{"NAME", "CREATED", "EXPIRES", "EXPIRED", "LOCKED", "AUTORENEW", "OUR DNS"}}
+			for _, domain := range domains {
+				t.add(str(domain.Name), date(domain.Created), date(domain.Expires), boolean(domain.IsExpired),
+					boolean(domain.IsLocked), boolean(domain.AutoRenew), boolean(domain.IsOurDNS))
+			}
+			return c.print(domains, t)
+		},
+	})
+
+	register(&command{
+		path:    []string{"domains", "check"},
+		args:    "DOMAIN...",
+		summary: "Check the availability of domains",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, -1); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.Domains.CheckContext(ctx, args)
+			if err != nil {
+				return err
+			}
+
+			var results []namecheap.DomainCheckResult
+			if response.DomainCheckResults != nil {
+				results = *response.DomainCheckResults
+			}
+
+			t := &table{header: []string{"DOMAIN", "AVAILABLE", "PREMIUM", "REGISTRATION PRICE", "RENEWAL PRICE", "DESCRIPTION"}}
+			for _, result := range results {
+				t.add(str(result.Domain), str(result.Available), str(result.IsPremiumName),
+					str(result.PremiumRegistrationPrice), str(result.PremiumRenewalPrice), str(result.Description))
+			}
+			return c.print(results, t)
+		},
+	})
+
+	register(&command{
+		path:    []string{"domains", "info"},
+		args:    "DOMAIN",
+		summary: "Show the details of a domain",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.Domains.GetInfoContext(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			info := response.DomainDNSGetListResult
+			t := &table{}
+			if info != nil {
+				t.add("Domain", str(info.DomainName))
+				t.add("Status", str(info.Status))
+				t.add("Owner", str(info.OwnerName))
+				t.add("Premium", boolean(info.IsPremium))
+				if details := info.DomainDetails; details != nil {
+					t.add("Created", date(details.CreatedDate))
+					t.add("Expires", date(details.ExpiredDate))
+				}
+				if whoisguard := info.Whoisguard; whoisguard != nil {
+					t.add("Whoisguard", str(whoisguard.Enabled))
+				}
+				if dns := info.DnsDetails; dns != nil {
+					t.add("DNS provider", str(dns.ProviderType))
+					t.add("Email type", str(dns.EmailType))
+					if dns.Nameservers != nil {
+						t.add("Nameservers", strings.Join(*dns.Nameservers, ", "))
+					}
+				}
+			}
+			return c.print(info, t)
+		},
+	})
+
+	var years int
+	register(&command{
+		path:    []string{"domains", "renew"},
+		args:    "DOMAIN",
+		summary: "Renew a domain, the account is charged",
+		flags: func(fs *flag.FlagSet) {
+			fs.IntVar(&years, "years", 1, "number of years to renew the domain for")
+		},
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.Domains.RenewContext(ctx, args[0], &namecheap.RenewArgs{Years: namecheap.Int(years)})
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainRenewResult
+			t := &table{header: []string{"DOMAIN", "RENEWED", "ORDER", "TRANSACTION", "CHARGED", "EXPIRES"}}
+			if result != nil {
+				expires := ""
+				if result.DomainDetails != nil {
+					expires = str(result.DomainDetails.ExpiredDate)
+				}
+				t.add(str(result.DomainName), boolean(result.Renew), integer(result.OrderID),
+					integer(result.TransactionID), str(result.ChargedAmount), expires)
+			}
+			return c.print(result, t)
+		},
+	})
+
+	for _, action := range []namecheap.LockAction{namecheap.LockActionLock, namecheap.LockActionUnlock} {
+		action := action
+		name := strings.ToLower(string(action))
+		register(&command{
+			path:    []string{"domains", name},
+			args:    "DOMAIN",
+			summary: "Set the registrar lock of a domain to " + name,
+			run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+				if err := expectArgs(args, 1, 1); err != nil {
+					return err
+				}
+				client, err := c.apiClient()
+				if err != nil {
+					return err
+				}
+
+				response, err := client.Domains.SetRegistrarLockContext(ctx, args[0], &action)
+				if err != nil {
+					return err
+				}
+
+				result := response.Result
+				t := &table{header: []string{"DOMAIN", "SUCCESS"}}
+				if result != nil {
+					t.add(str(result.Domain), boolean(result.IsSuccess))
+				}
+				return c.print(result, t)
+			},
+		})
+	}
+
+	register(&command{
+		path:    []string{"domains", "contacts"},
+		args:    "DOMAIN",
+		summary: "Show the contacts of a domain",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 1, 1); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.Domains.GetContactsContext(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainContactsResult
+			t := &table{header: []string{"ROLE", "NAME", "ORGANIZATION", "EMAIL", "PHONE", "COUNTRY"}}
+			if result != nil {
+				contacts := []struct {
+					role    string
+					contact *namecheap.DomainContactInfo
+				}{
+					{"Registrant", result.Registrant},
+					{"Tech", result.Tech},
+					{"Admin", result.Admin},
+					{"AuxBilling", result.AuxBilling},
+				}
+				for _, entry := range contacts {
+					if entry.contact == nil {
+						continue
+					}
+					name := strings.TrimSpace(str(entry.contact.FirstName) + " " + str(entry.contact.LastName))
+					t.add(entry.role, name, str(entry.contact.OrganizationName), str(entry.contact.EmailAddress),
+						str(entry.contact.Phone), str(entry.contact.Country))
+				}
+			}
+			return c.print(result, t)
+		},
+	})
+}
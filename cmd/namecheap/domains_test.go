@@ -0,0 +1,109 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheaptest"
+)
+
+func TestDomainsCommands(t *testing.T) {
+	setup := func(t *testing.T) (*namecheaptest.Server, map[string]string) {
+		server := namecheaptest.NewServer()
+		t.Cleanup(server.Close)
+
+		server.AddDomain(namecheaptest.Domain{
+			Name:    "domain.com",
+			Created: time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC),
+			Expires: time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC),
+			Contacts: namecheaptest.Contacts{
+				Registrant: namecheaptest.Contact{FirstName: "John", LastName: "Smith", EmailAddress: "john@domain.com", Country: "US"},
+			},
+		})
+		server.AddDomain(namecheaptest.Domain{Name: "other.net", Locked: true})
+
+		return server, testEnv(t, server)
+	}
+
+	t.Run("list", func(t *testing.T) {
+		_, env := setup(t)
+
+		stdout, stderr, code := runCLI(env, "domains", "list")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "NAME")
+		assert.Contains(t, stdout, "domain.com  2020-01-15  2030-01-15")
+		assert.Contains(t, stdout, "other.net")
+	})
+
+	t.Run("list_search", func(t *testing.T) {
+		_, env := setup(t)
+
+		stdout, stderr, code := runCLI(env, "domains", "list", "--search", "other", "--output", "json")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, `"Name": "other.net"`)
+		assert.NotContains(t, stdout, "domain.com")
+	})
+
+	t.Run("check", func(t *testing.T) {
+		server, env := setup(t)
+		server.SetUnavailable("taken.com")
+
+		stdout, stderr, code := runCLI(env, "domains", "check", "free.com", "taken.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Regexp(t, `free\.com\s+true`, stdout)
+		assert.Regexp(t, `taken\.com\s+false`, stdout)
+	})
+
+	t.Run("info", func(t *testing.T) {
+		_, env := setup(t)
+
+		stdout, stderr, code := runCLI(env, "domains", "info", "domain.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Regexp(t, `Domain\s+domain\.com`, stdout)
+		assert.Regexp(t, `Expires\s+2030-01-15`, stdout)
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		server, env := setup(t)
+		balance := server.Balance()
+
+		stdout, stderr, code := runCLI(env, "domains", "renew", "domain.com", "--years", "2", "--output", "yaml")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "DomainName: domain.com")
+		assert.Contains(t, stdout, "Renew: true")
+		assert.Less(t, server.Balance(), balance)
+
+		domain, _ := server.Domain("domain.com")
+		assert.Equal(t, 2032, domain.Expires.Year())
+	})
+
+	t.Run("lock_unlock", func(t *testing.T) {
+		server, env := setup(t)
+
+		_, stderr, code := runCLI(env, "domains", "lock", "domain.com")
+		assert.Equal(t, 0, code, stderr)
+		domain, _ := server.Domain("domain.com")
+		assert.True(t, domain.Locked)
+
+		_, stderr, code = runCLI(env, "domains", "unlock", "domain.com")
+		assert.Equal(t, 0, code, stderr)
+		domain, _ = server.Domain("domain.com")
+		assert.False(t, domain.Locked)
+	})
+
+	t.Run("contacts", func(t *testing.T) {
+		_, env := setup(t)
+
+		stdout, stderr, code := runCLI(env, "domains", "contacts", "domain.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Regexp(t, `Registrant\s+John Smith\s+john@domain\.com`, stdout)
+	})
+}
@@ -0,0 +1,275 @@
+// Command namecheap is a command-line client of the Namecheap API built on the namecheap package.
+//
+// Usage:
+//
+//	namecheap [--config FILE] [--sandbox] [--output table|json|yaml] GROUP COMMAND [FLAGS] [ARGS]
+//
+// The credentials are read from the config file and the NAMECHEAP_* environment variables,
+// run namecheap --help for the list of commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// command is a subcommand of the CLI, e.g. "domains list"
+type command struct {
+	path    []string
+	args    string
+	summary string
+	run     func(ctx context.Context, c *cli, fs *flag.FlagSet, args []string) error
+	// flags registers the flags of the command, they are parsed before run is called
+	flags func(fs *flag.FlagSet)
+}
+
+func (cmd *command) name() string {
+	return strings.Join(cmd.path, " ")
+}
+
+// commands lists the subcommands, they are registered by the init functions of the group files
+var commands []*command
+
+func register(cmd *command) {
+	commands = append(commands, cmd)
+}
+
+// cli holds the global settings and the streams of a run
+type cli struct {
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+
+	configPath string
+	// sandbox is nil unless --sandbox was passed, so that the config file applies
+	sandbox *bool
+	output  string
+
+	client *namecheap.Client
+}
+
+// errUsage is returned when the command line is invalid, the usage is printed with it
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr, os.Getenv))
+}
+
+// run executes the command line and returns the exit code
+func run(ctx context.Context, args []string, stdout, stderr io.Writer, getenv func(string) string) int {
+	c := &cli{stdout: stdout, stderr: stderr, getenv: getenv}
+
+	global := flag.NewFlagSet("namecheap", flag.ContinueOnError)
+	global.SetOutput(io.Discard)
+	c.addGlobalFlags(global)
+
+	if err := global.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			c.usage(stdout)
+			return 0
+		}
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n\n", err)
+		c.usage(stderr)
+		return 2
+	}
+
+	cmd, rest := findCommand(global.Args())
+	if cmd == nil {
+		if len(global.Args()) > 0 {
+			_, _ = fmt.Fprintf(stderr, "Error: unknown command %q\n\n", strings.Join(global.Args(), " "))
+		}
+		c.usage(stderr)
+		return 2
+	}
+
+	fs := flag.NewFlagSet("namecheap "+cmd.name(), flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	c.addGlobalFlags(fs)
+	if cmd.flags != nil {
+		cmd.flags(fs)
+	}
+
+	positional, err := parseFlags(fs, rest)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			c.commandUsage(stdout, cmd, fs)
+			return 0
+		}
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n\n", err)
+		c.commandUsage(stderr, cmd, fs)
+		return 2
+	}
+
+	if err := cmd.run(ctx, c, fs, positional); err != nil {
+		if errors.Is(err, errUsage) {
+			c.commandUsage(stderr, cmd, fs)
+			return 2
+		}
+		_, _ = fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func (c *cli) addGlobalFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.configPath, "config", c.configPath, "config file with the credentials, default: $NAMECHEAP_CONFIG or "+defaultConfigPathHelp)
+	fs.BoolFunc("sandbox", "use the sandbox API, --sandbox=false overrides the config file", func(value string) error {
+		sandbox, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		c.sandbox = &sandbox
+		return nil
+	})
+	fs.StringVar(&c.output, "output", c.outputOrDefault(), "output format: table, json or yaml")
+}
+
+func (c *cli) outputOrDefault() string {
+	if c.output == "" {
+		return outputTable
+	}
+	return c.output
+}
+
+// findCommand returns the command matching the longest prefix of args and the remaining args
+func findCommand(args []string) (*command, []string) {
+	var found *command
+	for _, cmd := range commands {
+		if len(cmd.path) > len(args) || (found != nil && len(cmd.path) <= len(found.path)) {
+			continue
+		}
+
+		matches := true
+		for i, part := range cmd.path {
+			if args[i] != part {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			found = cmd
+		}
+	}
+
+	if found == nil {
+		return nil, nil
+	}
+	return found, args[len(found.path):]
+}
+
+// parseFlags parses the flags placed before, between or after the positional arguments.
+// The arguments following a "--" are positional, even when they start with a dash.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		parsed := args[:len(args)-len(fs.Args())]
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		if endsWithTerminator(fs, parsed) {
+			return append(positional, args...), nil
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// endsWithTerminator reports whether the parsed arguments end with a "--" which stopped the parsing,
+// rather than a "--" passed as the value of a flag
+func endsWithTerminator(fs *flag.FlagSet, parsed []string) bool {
+	if len(parsed) == 0 || parsed[len(parsed)-1] != "--" {
+		return false
+	}
+	if len(parsed) == 1 {
+		return true
+	}
+
+	previous := parsed[len(parsed)-2]
+	if !strings.HasPrefix(previous, "-") || strings.Contains(previous, "=") {
+		return true
+	}
+	f := fs.Lookup(strings.TrimLeft(previous, "-"))
+	if f == nil {
+		return true
+	}
+	boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && boolFlag.IsBoolFlag()
+}
+
+func (c *cli) usage(w io.Writer) {
+	_, _ = fmt.Fprintln(w, "Usage: namecheap [--config FILE] [--sandbox] [--output table|json|yaml] GROUP COMMAND [FLAGS] [ARGS]")
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "Commands:")
+
+	sorted := append([]*command{}, commands...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].name() < sorted[j].name()
+	})
+
+	for _, cmd := range sorted {
+		_, _ = fmt.Fprintf(w, "  %-32s %s\n", strings.TrimSpace(cmd.name()+" "+cmd.args), cmd.summary)
+	}
+
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "Credentials are read from the config file and the environment variables")
+	_, _ = fmt.Fprintln(w, "NAMECHEAP_USER_NAME, NAMECHEAP_API_USER, NAMECHEAP_API_KEY, NAMECHEAP_CLIENT_IP and NAMECHEAP_SANDBOX.")
+}
+
+func (c *cli) commandUsage(w io.Writer, cmd *command, fs *flag.FlagSet) {
+	_, _ = fmt.Fprintf(w, "Usage: namecheap %s [FLAGS] %s\n\n%s\n\nFlags:\n", cmd.name(), cmd.args, cmd.summary)
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+	fs.SetOutput(io.Discard)
+}
+
+// apiClient returns the client configured from the config file, the environment and the flags
+func (c *cli) apiClient() (*namecheap.Client, error) {
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	cfg, err := loadConfig(c.configPath, c.getenv)
+	if err != nil {
+		return nil, err
+	}
+	if c.sandbox != nil {
+		cfg.Sandbox = *c.sandbox
+	}
+
+	client, err := cfg.newClient()
+	if err != nil {
+		return nil, err
+	}
+
+	c.client = client
+	return client, nil
+}
+
+// expectArgs checks the number of positional arguments
+func expectArgs(args []string, minArgs, maxArgs int) error {
+	if len(args) < minArgs || (maxArgs >= 0 && len(args) > maxArgs) {
+		return errUsage
+	}
+	return nil
+}
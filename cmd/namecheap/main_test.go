@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheaptest"
+)
+
+// testEnv returns the environment pointing the CLI to the server with its credentials and an empty config file
+func testEnv(t *testing.T, server *namecheaptest.Server) map[string]string {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{"NAMECHEAP_CONFIG": configPath}
+	if server != nil {
+		options := server.ClientOptions()
+		env["NAMECHEAP_USER_NAME"] = options.UserName
+		env["NAMECHEAP_API_USER"] = options.ApiUser
+		env["NAMECHEAP_API_KEY"] = options.ApiKey
+		env["NAMECHEAP_CLIENT_IP"] = options.ClientIp
+		env["NAMECHEAP_API_URL"] = server.URL
+	}
+	return env
+}
+
+// mockEnv returns the environment pointing the CLI to a server answering every request with response,
+// the params of the last request are stored in sent
+func mockEnv(t *testing.T, response string, sent *url.Values) map[string]string {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		*sent, _ = url.ParseQuery(string(body))
+		_, _ = writer.Write([]byte(response))
+	}))
+	t.Cleanup(mockServer.Close)
+
+	env := testEnv(t, nil)
+	env["NAMECHEAP_API_USER"] = "user"
+	env["NAMECHEAP_API_KEY"] = "key"
+	env["NAMECHEAP_CLIENT_IP"] = "10.10.10.10"
+	env["NAMECHEAP_API_URL"] = mockServer.URL
+	return env
+}
+
+// runCLI runs the command line with the environment and returns the output and the exit code
+func runCLI(env map[string]string, args ...string) (stdout, stderr string, code int) {
+	var out, errOut bytes.Buffer
+	code = run(context.Background(), args, &out, &errOut, func(name string) string {
+		return env[name]
+	})
+	return out.String(), errOut.String(), code
+}
+
+func TestRun(t *testing.T) {
+	t.Run("help", func(t *testing.T) {
+		stdout, _, code := runCLI(testEnv(t, nil), "--help")
+
+		assert.Equal(t, 0, code)
+		assert.Contains(t, stdout, "Usage: namecheap")
+		assert.Contains(t, stdout, "dns hosts get DOMAIN")
+		assert.Contains(t, stdout, "users balances")
+	})
+
+	t.Run("command_help", func(t *testing.T) {
+		stdout, _, code := runCLI(testEnv(t, nil), "domains", "renew", "--help")
+
+		assert.Equal(t, 0, code)
+		assert.Contains(t, stdout, "Usage: namecheap domains renew [FLAGS] DOMAIN")
+		assert.Contains(t, stdout, "-years")
+		assert.Contains(t, stdout, "-sandbox")
+	})
+
+	t.Run("no_command", func(t *testing.T) {
+		_, stderr, code := runCLI(testEnv(t, nil))
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr, "Usage: namecheap")
+	})
+
+	t.Run("unknown_command", func(t *testing.T) {
+		_, stderr, code := runCLI(testEnv(t, nil), "domains", "explode")
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr, `Error: unknown command "domains explode"`)
+	})
+
+	t.Run("unknown_flag", func(t *testing.T) {
+		_, stderr, code := runCLI(testEnv(t, nil), "domains", "info", "--nope", "domain.com")
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr, "flag provided but not defined: -nope")
+	})
+
+	t.Run("wrong_number_of_args", func(t *testing.T) {
+		_, stderr, code := runCLI(testEnv(t, nil), "domains", "info")
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr, "Usage: namecheap domains info [FLAGS] DOMAIN")
+	})
+
+	t.Run("missing_credentials", func(t *testing.T) {
+		_, stderr, code := runCLI(testEnv(t, nil), "users", "balances")
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, "Error: api_user is required")
+	})
+
+	t.Run("api_error", func(t *testing.T) {
+		server := namecheaptest.NewServer()
+		defer server.Close()
+
+		_, stderr, code := runCLI(testEnv(t, server), "domains", "info", "unknown.com")
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, "Error: ")
+		assert.Contains(t, stderr, "unknown.com")
+	})
+
+	t.Run("flags_after_args", func(t *testing.T) {
+		server := namecheaptest.NewServer()
+		defer server.Close()
+		server.AddDomain(namecheaptest.Domain{Name: "domain.com"})
+
+		stdout, _, code := runCLI(testEnv(t, server), "domains", "lock", "domain.com", "--output", "json")
+
+		assert.Equal(t, 0, code)
+		assert.Contains(t, stdout, `"IsSuccess": true`)
+	})
+}
+
+func TestParseFlags(t *testing.T) {
+	parse := func(args ...string) ([]string, string, bool, error) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		address := fs.String("address", "", "")
+		dryRun := fs.Bool("dry-run", false, "")
+		positional, err := parseFlags(fs, args)
+		return positional, *address, *dryRun, err
+	}
+
+	positional, address, dryRun, err := parse("domain.com", "--address", "10.0.0.1", "other", "--dry-run")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"domain.com", "other"}, positional)
+	assert.Equal(t, "10.0.0.1", address)
+	assert.True(t, dryRun)
+
+	positional, _, dryRun, err = parse("domain.com", "--", "-v=spf1", "--dry-run")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"domain.com", "-v=spf1", "--dry-run"}, positional)
+	assert.False(t, dryRun)
+
+	positional, address, _, err = parse("--address", "--", "domain.com", "--dry-run")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"domain.com"}, positional)
+	assert.Equal(t, "--", address)
+
+	positional, _, dryRun, err = parse("--dry-run", "--", "-x")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"-x"}, positional)
+	assert.True(t, dryRun)
+}
+
+func TestFindCommand(t *testing.T) {
+	cmd, rest := findCommand([]string{"dns", "hosts", "get", "domain.com"})
+	if assert.NotNil(t, cmd) {
+		assert.Equal(t, "dns hosts get", cmd.name())
+	}
+	assert.Equal(t, []string{"domain.com"}, rest)
+
+	cmd, _ = findCommand([]string{"dns", "hosts"})
+	assert.Nil(t, cmd)
+}
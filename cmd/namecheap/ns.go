@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// splitDomain returns the SLD and TLD of a domain name for the nameserver commands
+func splitDomain(domain string) (sld, tld string, err error) {
+	parsed, err := namecheap.ParseDomain(domain)
+	if err != nil {
+		return "", "", err
+	}
+	if parsed.TRD != "" {
+		return "", "", fmt.Errorf("invalid domain %q: use the registered domain without subdomain", domain)
+	}
+	return parsed.SLD, parsed.TLD, nil
+}
+
+func init() {
+	register(&command{
+		path:    []string{"ns", "create"},
+		args:    "DOMAIN NAMESERVER IP",
+		summary: "Create a nameserver of a domain, e.g. ns1.domain.com",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 3, 3); err != nil {
+				return err
+			}
+			sld, tld, err := splitDomain(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsNS.CreateContext(ctx, sld, tld, args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainNameserverInfoResult
+			t := &table{header: []string{"DOMAIN", "NAMESERVER", "IP", "SUCCESS"}}
+			if result != nil {
+				t.add(str(result.Domain), str(result.Nameserver), str(result.IP), boolean(result.IsSuccess))
+			}
+			return c.print(result, t)
+		},
+	})
+
+	var oldIP, ip string
+	register(&command{
+		path:    []string{"ns", "update"},
+		args:    "DOMAIN NAMESERVER",
+		summary: "Change the IP address of a nameserver of a domain",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&oldIP, "old-ip", "", "current IP address of the nameserver")
+			fs.StringVar(&ip, "ip", "", "new IP address of the nameserver")
+		},
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 2, 2); err != nil {
+				return err
+			}
+			if oldIP == "" || ip == "" {
+				return fmt.Errorf("--old-ip and --ip are required")
+			}
+			sld, tld, err := splitDomain(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsNS.UpdateContext(ctx, sld, tld, args[1], oldIP, ip)
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainNameserverUpdateResult
+			t := &table{header: []string{"DOMAIN", "NAMESERVER", "SUCCESS"}}
+			if result != nil {
+				t.add(str(result.Domain), str(result.Nameserver), boolean(result.IsSuccess))
+			}
+			return c.print(result, t)
+		},
+	})
+
+	register(&command{
+		path:    []string{"ns", "delete"},
+		args:    "DOMAIN NAMESERVER",
+		summary: "Delete a nameserver of a domain",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 2, 2); err != nil {
+				return err
+			}
+			sld, tld, err := splitDomain(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsNS.DeleteContext(ctx, sld, tld, args[1])
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainNameserverDeleteResult
+			t := &table{header: []string{"DOMAIN", "NAMESERVER", "SUCCESS"}}
+			if result != nil {
+				t.add(str(result.Domain), str(result.Nameserver), boolean(result.IsSuccess))
+			}
+			return c.print(result, t)
+		},
+	})
+
+	register(&command{
+		path:    []string{"ns", "info"},
+		args:    "DOMAIN NAMESERVER",
+		summary: "Show the IP address and statuses of a nameserver of a domain",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 2, 2); err != nil {
+				return err
+			}
+			sld, tld, err := splitDomain(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.DomainsNS.GetInfoContext(ctx, sld, tld, args[1])
+			if err != nil {
+				return err
+			}
+
+			result := response.DomainNameserverInfoResult
+			t := &table{header: []string{"DOMAIN", "NAMESERVER", "IP", "STATUSES"}}
+			if result != nil {
+				statuses := ""
+				if result.NameserverStatuses.Nameservers != nil {
+					statuses = strings.Join(*result.NameserverStatuses.Nameservers, ", ")
+				}
+				t.add(str(result.Domain), str(result.Nameserver), str(result.IP), statuses)
+			}
+			return c.print(result, t)
+		},
+	})
+}
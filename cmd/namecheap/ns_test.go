@@ -0,0 +1,102 @@
+package main
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNSCommands(t *testing.T) {
+	response := func(command, result string) string {
+		return `
+			<?xml version="1.0" encoding="UTF-8"?>
+			<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+				<Errors />
+				<RequestedCommand>` + command + `</RequestedCommand>
+				<CommandResponse Type="` + command + `">` + result + `</CommandResponse>
+			</ApiResponse>
+		`
+	}
+
+	t.Run("create", func(t *testing.T) {
+		var sent url.Values
+		env := mockEnv(t, response("namecheap.domains.ns.create",
+			`<DomainNSCreateResult Domain="domain.com" Nameserver="ns1.domain.com" IP="10.0.0.1" IsSuccess="true" />`), &sent)
+
+		stdout, stderr, code := runCLI(env, "ns", "create", "domain.com", "ns1.domain.com", "10.0.0.1")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, "namecheap.domains.ns.create", sent.Get("Command"))
+		assert.Equal(t, "domain", sent.Get("SLD"))
+		assert.Equal(t, "com", sent.Get("TLD"))
+		assert.Equal(t, "ns1.domain.com", sent.Get("Nameserver"))
+		assert.Equal(t, "10.0.0.1", sent.Get("IP"))
+		assert.Regexp(t, `domain\.com\s+ns1\.domain\.com\s+10\.0\.0\.1\s+true`, stdout)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		var sent url.Values
+		env := mockEnv(t, response("namecheap.domains.ns.update",
+			`<DomainNSUpdateResult Domain="domain.co.uk" Nameserver="ns1.domain.co.uk" IsSuccess="true" />`), &sent)
+
+		_, stderr, code := runCLI(env, "ns", "update", "domain.co.uk", "ns1.domain.co.uk", "--old-ip", "10.0.0.1", "--ip", "10.0.0.2")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, "namecheap.domains.ns.update", sent.Get("Command"))
+		assert.Equal(t, "domain", sent.Get("SLD"))
+		assert.Equal(t, "co.uk", sent.Get("TLD"))
+		assert.Equal(t, "10.0.0.1", sent.Get("OldIP"))
+		assert.Equal(t, "10.0.0.2", sent.Get("IP"))
+	})
+
+	t.Run("update_missing_ip", func(t *testing.T) {
+		var sent url.Values
+		env := mockEnv(t, "", &sent)
+
+		_, stderr, code := runCLI(env, "ns", "update", "domain.com", "ns1.domain.com", "--ip", "10.0.0.2")
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, "--old-ip and --ip are required")
+		assert.Nil(t, sent)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		var sent url.Values
+		env := mockEnv(t, response("namecheap.domains.ns.delete",
+			`<DomainNSDeleteResult Domain="domain.com" Nameserver="ns1.domain.com" IsSuccess="true" />`), &sent)
+
+		stdout, stderr, code := runCLI(env, "ns", "delete", "domain.com", "ns1.domain.com", "--output", "json")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, "namecheap.domains.ns.delete", sent.Get("Command"))
+		assert.Contains(t, stdout, `"IsSuccess": true`)
+	})
+
+	t.Run("info", func(t *testing.T) {
+		var sent url.Values
+		env := mockEnv(t, response("namecheap.domains.ns.getInfo", `
+			<DomainNSInfoResult Domain="domain.com" Nameserver="ns1.domain.com" IP="10.0.0.1">
+				<NameserverStatuses>
+					<Status>OK</Status>
+					<Status>Linked</Status>
+				</NameserverStatuses>
+			</DomainNSInfoResult>`), &sent)
+
+		stdout, stderr, code := runCLI(env, "ns", "info", "domain.com", "ns1.domain.com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, "namecheap.domains.ns.getInfo", sent.Get("Command"))
+		assert.Regexp(t, `ns1\.domain\.com\s+10\.0\.0\.1\s+OK, Linked`, stdout)
+	})
+
+	t.Run("subdomain", func(t *testing.T) {
+		var sent url.Values
+		env := mockEnv(t, "", &sent)
+
+		_, stderr, code := runCLI(env, "ns", "info", "www.domain.com", "ns1.domain.com")
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, "use the registered domain without subdomain")
+	})
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// table is the tabular rendering of a result
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(row ...string) {
+	t.rows = append(t.rows, row)
+}
+
+// print writes the result in the selected output format, value is the result marshalled to JSON and YAML
+// and t is its table rendering
+func (c *cli) print(value any, t *table) error {
+	switch c.output {
+	case outputTable, "":
+		return c.printTable(t)
+	case outputJSON:
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(c.stdout, string(data))
+		return err
+	case outputYAML:
+		return c.printYAML(value)
+	default:
+		return fmt.Errorf("unknown output format %q, use table, json or yaml", c.output)
+	}
+}
+
+func (c *cli) printTable(t *table) error {
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+
+	if len(t.header) > 0 {
+		_, _ = fmt.Fprintln(w, strings.Join(t.header, "\t"))
+	}
+	for _, row := range t.rows {
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	return w.Flush()
+}
+
+// printYAML converts the JSON encoding of value to YAML, so that both formats have the same field names and order
+func (c *cli) printYAML(value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	resetStyle(&node)
+
+	encoder := yaml.NewEncoder(c.stdout)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// resetStyle turns the JSON flow style of the decoded nodes into the YAML block style
+func resetStyle(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!str" {
+		node.Style = 0
+		if needsQuotes(node.Value) {
+			node.Style = yaml.DoubleQuotedStyle
+		}
+	} else {
+		node.Style = 0
+	}
+
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// needsQuotes reports whether a string would be read back as another type without quotes
+func needsQuotes(value string) bool {
+	var decoded any
+	if err := yaml.Unmarshal([]byte(value), &decoded); err != nil {
+		return true
+	}
+	_, ok := decoded.(string)
+	return !ok || decoded != value
+}
+
+func str(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func integer(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+func boolean(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatBool(*value)
+}
+
+func date(value *namecheap.DateTime) string {
+	if value == nil || value.IsZero() {
+		return ""
+	}
+	return value.Format("2006-01-02")
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func TestPrint(t *testing.T) {
+	type item struct {
+		Name    *string
+		Count   int
+		Enabled bool
+		Version string
+	}
+	value := []item{{Name: namecheap.String("first"), Count: 2, Enabled: true, Version: "1.0"}, {Count: 3}}
+	rows := &table{header: []string{"NAME", "COUNT"}, rows: [][]string{{"first", "2"}, {"", "3"}}}
+
+	cases := []struct {
+		output   string
+		expected string
+	}{
+		{outputTable, "NAME   COUNT\nfirst  2\n       3\n"},
+		{outputJSON, `[
+  {
+    "Name": "first",
+    "Count": 2,
+    "Enabled": true,
+    "Version": "1.0"
+  },
+  {
+    "Name": null,
+    "Count": 3,
+    "Enabled": false,
+    "Version": ""
+  }
+]
+`},
+		{outputYAML, `- Name: first
+  Count: 2
+  Enabled: true
+  Version: "1.0"
+- Name: null
+  Count: 3
+  Enabled: false
+  Version: ""
+`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.output, func(t *testing.T) {
+			var out bytes.Buffer
+			c := &cli{stdout: &out, output: tc.output}
+
+			err := c.print(value, rows)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, out.String())
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		c := &cli{stdout: &bytes.Buffer{}, output: "xml"}
+
+		err := c.print(value, rows)
+
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), `unknown output format "xml"`)
+		}
+	})
+}
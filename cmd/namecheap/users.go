@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func init() {
+	register(&command{
+		path:    []string{"users", "balances"},
+		summary: "Show the balances of the account",
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 0, 0); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.Users.GetBalancesContext(ctx)
+			if err != nil {
+				return err
+			}
+
+			result := response.UserGetBalancesResult
+			t := &table{header: []string{"CURRENCY", "AVAILABLE", "ACCOUNT", "EARNED", "WITHDRAWABLE", "REQUIRED FOR AUTORENEW"}}
+			if result != nil {
+				t.add(str(result.Currency), str(result.AvailableBalance), str(result.AccountBalance), str(result.EarnedAmount),
+					str(result.WithdrawableAmount), str(result.FundsRequiredForAutoRenew))
+			}
+			return c.print(result, t)
+		},
+	})
+
+	var productType, category, action, product, promotion string
+	register(&command{
+		path:    []string{"users", "pricing"},
+		summary: "Show the prices of the products for the account",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&productType, "type", string(namecheap.ProductTypeDomain), "product type: DOMAIN or SSLCERTIFICATE")
+			fs.StringVar(&category, "category", "", "product category, e.g. DOMAINS or COMODO")
+			fs.StringVar(&action, "action", "", "action, e.g. REGISTER, RENEW, REACTIVATE, TRANSFER or PURCHASE")
+			fs.StringVar(&product, "product", "", "product name, e.g. COM or INSTANTSSL")
+			fs.StringVar(&promotion, "promotion-code", "", "promotion code")
+		},
+		run: func(ctx context.Context, c *cli, _ *flag.FlagSet, args []string) error {
+			if err := expectArgs(args, 0, 0); err != nil {
+				return err
+			}
+			client, err := c.apiClient()
+			if err != nil {
+				return err
+			}
+
+			pricingArgs := &namecheap.GetPricingArgs{ProductType: namecheap.ProductType(strings.ToUpper(productType))}
+			if category != "" {
+				value := namecheap.ProductCategory(strings.ToUpper(category))
+				pricingArgs.ProductCategory = &value
+			}
+			if action != "" {
+				value := namecheap.ActionName(strings.ToUpper(action))
+				pricingArgs.ActionName = &value
+			}
+			if product != "" {
+				value := namecheap.ProductName(strings.ToUpper(product))
+				pricingArgs.ProductName = &value
+			}
+			if promotion != "" {
+				pricingArgs.PromotionCode = namecheap.String(promotion)
+			}
+
+			response, err := client.Users.GetPricingContext(ctx, pricingArgs)
+			if err != nil {
+				return err
+			}
+
+			result := response.UserGetPricingResult
+			return c.print(result, pricingTable(result))
+		},
+	})
+}
+
+// pricingTable flattens the product types, categories and products into a row per price
+func pricingTable(result *namecheap.GetPricingResult) *table {
+	t := &table{header: []string{"TYPE", "CATEGORY", "PRODUCT", "DURATION", "PRICE", "REGULAR PRICE", "YOUR PRICE", "CURRENCY"}}
+	if result == nil || result.ProductTypes == nil {
+		return t
+	}
+
+	for _, productType := range *result.ProductTypes {
+		if productType.ProductCategories == nil {
+			continue
+		}
+		for _, category := range *productType.ProductCategories {
+			if category.Products == nil {
+				continue
+			}
+			for _, product := range *category.Products {
+				if product.Prices == nil {
+					continue
+				}
+				for _, price := range *product.Prices {
+					duration := strings.TrimSpace(str(price.Duration) + " " + strings.ToLower(str(price.DurationType)))
+					t.add(str(productType.Name), str(category.Name), str(product.Name), duration,
+						str(price.Price), str(price.RegularPrice), str(price.YourPrice), str(price.Currency))
+				}
+			}
+		}
+	}
+	return t
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheaptest"
+)
+
+func TestUsersCommands(t *testing.T) {
+	t.Run("balances", func(t *testing.T) {
+		server := namecheaptest.NewServer()
+		defer server.Close()
+		server.SetBalance(123.45)
+
+		stdout, stderr, code := runCLI(testEnv(t, server), "users", "balances")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Regexp(t, `USD\s+123\.45\s+123\.45`, stdout)
+	})
+
+	t.Run("pricing", func(t *testing.T) {
+		server := namecheaptest.NewServer()
+		defer server.Close()
+		server.SetPrice(namecheap.ActionNameRenew, "com", 11.5)
+
+		stdout, stderr, code := runCLI(testEnv(t, server), "users", "pricing", "--action", "renew", "--product", "com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Regexp(t, `(?i)domains\s+renew\s+com\s+1 year\s+11\.5`, stdout)
+	})
+
+	t.Run("pricing_yaml", func(t *testing.T) {
+		server := namecheaptest.NewServer()
+		defer server.Close()
+
+		stdout, stderr, code := runCLI(testEnv(t, server), "--output", "yaml", "users", "pricing", "--action", "register", "--product", "com")
+
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "ProductTypes:")
+		assert.Contains(t, stdout, "Name: com")
+	})
+}
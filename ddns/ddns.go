@@ -0,0 +1,287 @@
+// Package ddns keeps the A and AAAA records of hosts pointing to the current public address of the network,
+// e.g. for offices or home routers behind changing IPs.
+//
+// The Updater discovers the public addresses with a Resolver, compares them with the host records
+// returned by GetHosts and only replaces the A and AAAA records of the configured hosts. The other records
+// and the email type are kept, and nothing is written when the records were modified concurrently:
+//
+//	updater, err := ddns.NewUpdater(client, ddns.Options{
+//		Domain: "domain.com",
+//		Hosts:  []string{"@", "office"},
+//		IPv4:   ddns.NewHTTPResolver(ddns.DefaultIPv4URL),
+//		OnChange: func(change ddns.Change) {
+//			log.Println("updated", change)
+//		},
+//	})
+//
+//	err = updater.Run(ctx)
+package ddns
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// DefaultInterval is the time between two updates of Updater.Run
+const DefaultInterval = 5 * time.Minute
+
+// Options configures an Updater
+type Options struct {
+	Domain string
+	// Hosts are the host names of the records, e.g. "@" for the domain itself or "office"
+	Hosts []string
+	// IPv4 discovers the address of the A records, the A records are left alone when nil
+	IPv4 Resolver
+	// IPv6 discovers the address of the AAAA records, the AAAA records are left alone when nil
+	IPv6 Resolver
+	// TTL is the TTL of the updated records.
+	// When 0 the TTL of the replaced record is kept and new records get the server default.
+	TTL int
+	// Interval is the time between two updates of Run. Default value: DefaultInterval
+	Interval time.Duration
+	// DryRun computes and reports the changes without applying them
+	DryRun bool
+	// OnChange is called for every changed record once the change is applied, or planned in a dry run
+	OnChange func(change Change)
+	// OnError is called with the errors of the updates of Run
+	OnError func(err error)
+}
+
+// Change is the update of the records of a host and a record type
+type Change struct {
+	Host       string
+	RecordType string
+	// Before are the addresses of the replaced records, empty when the record is created
+	Before []string
+	After  netip.Addr
+	// DryRun reports that the change was not applied
+	DryRun bool
+}
+
+func (c Change) String() string {
+	before := "none"
+	if len(c.Before) > 0 {
+		before = strings.Join(c.Before, ",")
+	}
+	return fmt.Sprintf("%s %s %s => %s", c.Host, c.RecordType, before, c.After)
+}
+
+// Result is the outcome of an update
+type Result struct {
+	// IPv4 and IPv6 are the discovered addresses, the zero netip.Addr when the resolver is not configured
+	IPv4 netip.Addr
+	IPv6 netip.Addr
+	// Changes are the applied changes, or the planned ones in a dry run
+	Changes []Change
+	// Plan is the plan of the changes of the host records
+	Plan *namecheap.ZonePlan
+}
+
+// Updater updates the A and AAAA records of hosts with the public addresses of the network
+type Updater struct {
+	client  *namecheap.Client
+	options Options
+}
+
+// NewUpdater returns an updater of the records of options.Domain
+func NewUpdater(client *namecheap.Client, options Options) (*Updater, error) {
+	if err := validateOptions(client, &options); err != nil {
+		return nil, err
+	}
+
+	hosts := make([]string, 0, len(options.Hosts))
+	for _, host := range options.Hosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if host == "" {
+			host = "@"
+		}
+		hosts = append(hosts, host)
+	}
+	options.Hosts = hosts
+
+	if options.Interval <= 0 {
+		options.Interval = DefaultInterval
+	}
+
+	return &Updater{client: client, options: options}, nil
+}
+
+func validateOptions(client *namecheap.Client, options *Options) error {
+	if client == nil {
+		return fmt.Errorf("client is required")
+	}
+	if options.Domain == "" {
+		return fmt.Errorf("Domain is required")
+	}
+	if len(options.Hosts) == 0 {
+		return fmt.Errorf("Hosts is required")
+	}
+	if options.IPv4 == nil && options.IPv6 == nil {
+		return fmt.Errorf("IPv4 or IPv6 resolver is required")
+	}
+	if options.TTL != 0 && (options.TTL < namecheap.MinTTL || options.TTL > namecheap.MaxTTL) {
+		return fmt.Errorf("invalid TTL %d: it must be between %d and %d", options.TTL, namecheap.MinTTL, namecheap.MaxTTL)
+	}
+	return nil
+}
+
+// target is the address a host and record type must point to
+type target struct {
+	host       string
+	recordType string
+	addr       netip.Addr
+}
+
+// Update discovers the public addresses and updates the records which don't point to them.
+// Nothing is written when the records are up to date. ErrZoneChanged is returned without
+// writing anything when the records were modified concurrently, the next update retries.
+func (u *Updater) Update(ctx context.Context) (*Result, error) {
+	result := &Result{}
+
+	var err error
+	if u.options.IPv4 != nil {
+		if result.IPv4, err = resolve(ctx, u.options.IPv4, namecheap.RecordTypeA); err != nil {
+			return nil, err
+		}
+	}
+	if u.options.IPv6 != nil {
+		if result.IPv6, err = resolve(ctx, u.options.IPv6, namecheap.RecordTypeAAAA); err != nil {
+			return nil, err
+		}
+	}
+
+	var targets []target
+	for _, host := range u.options.Hosts {
+		if result.IPv4.IsValid() {
+			targets = append(targets, target{host: host, recordType: namecheap.RecordTypeA, addr: result.IPv4})
+		}
+		if result.IPv6.IsValid() {
+			targets = append(targets, target{host: host, recordType: namecheap.RecordTypeAAAA, addr: result.IPv6})
+		}
+	}
+
+	var changes []Change
+	plan, err := u.client.DomainsDNS.PlanRecordsContext(ctx, u.options.Domain, func(records []namecheap.DomainsDNSHostRecord) ([]namecheap.DomainsDNSHostRecord, error) {
+		changes = nil
+		for _, target := range targets {
+			var change *Change
+			records, change = u.updateRecords(records, target)
+			if change != nil {
+				changes = append(changes, *change)
+			}
+		}
+		return records, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Plan = plan
+	if plan.IsEmpty() {
+		return result, nil
+	}
+
+	if !u.options.DryRun {
+		if err := u.client.DomainsDNS.ApplyPlanContext(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range changes {
+		changes[i].DryRun = u.options.DryRun
+		if u.options.OnChange != nil {
+			u.options.OnChange(changes[i])
+		}
+	}
+	result.Changes = changes
+
+	return result, nil
+}
+
+// Run updates the records right away and then every Interval until ctx is done.
+// The errors of the updates are passed to OnError, Run returns the error of ctx.
+func (u *Updater) Run(ctx context.Context) error {
+	ticker := time.NewTicker(u.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := u.Update(ctx); err != nil && ctx.Err() == nil && u.options.OnError != nil {
+			u.options.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// updateRecords replaces the records of the target host and record type with a single record
+// pointing to the target address, unless they already do
+func (u *Updater) updateRecords(records []namecheap.DomainsDNSHostRecord, target target) ([]namecheap.DomainsDNSHostRecord, *Change) {
+	var existing, rest []namecheap.DomainsDNSHostRecord
+	for _, record := range records {
+		if strings.EqualFold(stringValue(record.HostName), target.host) && strings.EqualFold(stringValue(record.RecordType), target.recordType) {
+			existing = append(existing, record)
+		} else {
+			rest = append(rest, record)
+		}
+	}
+
+	ttl := u.options.TTL
+	if len(existing) == 1 && sameAddr(stringValue(existing[0].Address), target.addr) &&
+		(ttl == 0 || (existing[0].TTL != nil && *existing[0].TTL == ttl)) {
+		return records, nil
+	}
+
+	record := namecheap.DomainsDNSHostRecord{
+		HostName:   namecheap.String(target.host),
+		RecordType: namecheap.String(target.recordType),
+		Address:    namecheap.String(target.addr.String()),
+	}
+	if ttl != 0 {
+		record.TTL = namecheap.Int(ttl)
+	} else if len(existing) > 0 {
+		record.TTL = existing[0].TTL
+	}
+
+	change := &Change{Host: target.host, RecordType: target.recordType, After: target.addr}
+	for _, replaced := range existing {
+		change.Before = append(change.Before, stringValue(replaced.Address))
+	}
+
+	return append(rest, record), change
+}
+
+// resolve discovers the address for the record type and checks its family
+func resolve(ctx context.Context, resolver Resolver, recordType string) (netip.Addr, error) {
+	addr, err := resolver.PublicIP(ctx)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("unable to discover the public address for the %s records: %w", recordType, err)
+	}
+
+	addr = addr.Unmap()
+	if !addr.IsValid() || (recordType == namecheap.RecordTypeA) != addr.Is4() {
+		return netip.Addr{}, fmt.Errorf("invalid public address %q for the %s records", addr, recordType)
+	}
+
+	return addr, nil
+}
+
+func sameAddr(address string, addr netip.Addr) bool {
+	parsed, err := netip.ParseAddr(address)
+	return err == nil && parsed.Unmap() == addr
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,287 @@
+package ddns
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheaptest"
+)
+
+func setupServer(t *testing.T) *namecheaptest.Server {
+	t.Helper()
+
+	server := namecheaptest.NewServer()
+	t.Cleanup(server.Close)
+
+	server.AddDomain(namecheaptest.Domain{
+		Name:      "domain.com",
+		EmailType: namecheap.EmailTypeMX,
+		Hosts: []namecheaptest.Host{
+			{Name: "@", Type: "A", Address: "10.0.0.1", TTL: 300},
+			{Name: "office", Type: "A", Address: "10.0.0.1"},
+			{Name: "www", Type: "CNAME", Address: "domain.com"},
+			{Name: "@", Type: "MX", Address: "mx.domain.com"},
+		},
+	})
+
+	return server
+}
+
+func hostAddresses(server *namecheaptest.Server, recordType string) map[string][]string {
+	domain, _ := server.Domain("domain.com")
+
+	addresses := map[string][]string{}
+	for _, host := range domain.Hosts {
+		if host.Type == recordType {
+			addresses[host.Name] = append(addresses[host.Name], host.Address)
+		}
+	}
+	return addresses
+}
+
+func TestNewUpdater(t *testing.T) {
+	client := namecheap.NewClient(&namecheap.ClientOptions{})
+	resolver := StaticResolver(netip.MustParseAddr("10.0.0.2"))
+
+	cases := []struct {
+		Name          string
+		Options       Options
+		ExpectedError string
+	}{
+		{Name: "domain", Options: Options{Hosts: []string{"@"}, IPv4: resolver}, ExpectedError: "Domain is required"},
+		{Name: "hosts", Options: Options{Domain: "domain.com", IPv4: resolver}, ExpectedError: "Hosts is required"},
+		{Name: "resolver", Options: Options{Domain: "domain.com", Hosts: []string{"@"}}, ExpectedError: "IPv4 or IPv6 resolver is required"},
+		{Name: "ttl", Options: Options{Domain: "domain.com", Hosts: []string{"@"}, IPv4: resolver, TTL: 30}, ExpectedError: "invalid TTL 30: it must be between 60 and 60000"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			_, err := NewUpdater(client, c.Options)
+			assert.EqualError(t, err, c.ExpectedError)
+		})
+	}
+}
+
+func TestUpdater(t *testing.T) {
+	t.Run("update", func(t *testing.T) {
+		server := setupServer(t)
+
+		var changes []Change
+		updater, err := NewUpdater(server.Client(), Options{
+			Domain:   "domain.com",
+			Hosts:    []string{"@", "Office", "vpn"},
+			IPv4:     StaticResolver(netip.MustParseAddr("10.0.0.2")),
+			IPv6:     StaticResolver(netip.MustParseAddr("2001:db8::1")),
+			OnChange: func(change Change) { changes = append(changes, change) },
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := updater.Update(context.Background())
+		if err != nil {
+			t.Fatal("Unable to update", err)
+		}
+
+		assert.Equal(t, netip.MustParseAddr("10.0.0.2"), result.IPv4)
+		assert.Len(t, result.Changes, 6)
+		assert.Equal(t, result.Changes, changes)
+		assert.Equal(t, "@ A 10.0.0.1 => 10.0.0.2", changes[0].String())
+		assert.Equal(t, "@ AAAA none => 2001:db8::1", changes[1].String())
+		assert.False(t, changes[0].DryRun)
+
+		assert.Equal(t, map[string][]string{"@": {"10.0.0.2"}, "office": {"10.0.0.2"}, "vpn": {"10.0.0.2"}}, hostAddresses(server, "A"))
+		assert.Equal(t, map[string][]string{"@": {"2001:db8::1"}, "office": {"2001:db8::1"}, "vpn": {"2001:db8::1"}}, hostAddresses(server, "AAAA"))
+
+		domain, _ := server.Domain("domain.com")
+		assert.Equal(t, namecheap.EmailTypeMX, domain.EmailType)
+		assert.Len(t, domain.Hosts, 8)
+		for _, host := range domain.Hosts {
+			if host.Name == "@" && host.Type == "A" {
+				assert.Equal(t, 300, host.TTL)
+			}
+		}
+
+		requests := len(server.Requests())
+		result, err = updater.Update(context.Background())
+		if err != nil {
+			t.Fatal("Unable to update", err)
+		}
+		assert.Empty(t, result.Changes)
+		assert.True(t, result.Plan.IsEmpty())
+		assert.Len(t, server.Requests(), requests+1)
+	})
+
+	t.Run("duplicate_records", func(t *testing.T) {
+		server := setupServer(t)
+		server.AddDomain(namecheaptest.Domain{
+			Name: "domain.com",
+			Hosts: []namecheaptest.Host{
+				{Name: "office", Type: "A", Address: "10.0.0.1"},
+				{Name: "office", Type: "A", Address: "10.0.0.2"},
+			},
+		})
+
+		updater, err := NewUpdater(server.Client(), Options{
+			Domain: "domain.com",
+			Hosts:  []string{"office"},
+			IPv4:   StaticResolver(netip.MustParseAddr("10.0.0.2")),
+			TTL:    60,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := updater.Update(context.Background())
+		if err != nil {
+			t.Fatal("Unable to update", err)
+		}
+		assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, result.Changes[0].Before)
+
+		domain, _ := server.Domain("domain.com")
+		assert.Len(t, domain.Hosts, 1)
+		assert.Equal(t, 60, domain.Hosts[0].TTL)
+	})
+
+	t.Run("dry_run", func(t *testing.T) {
+		server := setupServer(t)
+
+		var changes []Change
+		updater, err := NewUpdater(server.Client(), Options{
+			Domain:   "domain.com",
+			Hosts:    []string{"office"},
+			IPv4:     StaticResolver(netip.MustParseAddr("10.0.0.2")),
+			DryRun:   true,
+			OnChange: func(change Change) { changes = append(changes, change) },
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := updater.Update(context.Background())
+		if err != nil {
+			t.Fatal("Unable to update", err)
+		}
+
+		assert.Len(t, changes, 1)
+		assert.True(t, changes[0].DryRun)
+		assert.Equal(t, `~ office 1800 A "10.0.0.1" => office 1800 A "10.0.0.2"`, result.Plan.String())
+		assert.Equal(t, map[string][]string{"@": {"10.0.0.1"}, "office": {"10.0.0.1"}}, hostAddresses(server, "A"))
+	})
+
+	t.Run("concurrent_modification", func(t *testing.T) {
+		server := setupServer(t)
+
+		getHosts := 0
+		server.AddHook(func(command string, _ url.Values) *namecheaptest.Fault {
+			if command != "namecheap.domains.dns.getHosts" {
+				return nil
+			}
+			getHosts++
+			if getHosts == 2 {
+				domain, _ := server.Domain("domain.com")
+				domain.Hosts = append(domain.Hosts, namecheaptest.Host{Name: "new", Type: "A", Address: "10.0.0.9"})
+				server.AddDomain(domain)
+			}
+			return nil
+		})
+
+		updater, err := NewUpdater(server.Client(), Options{
+			Domain: "domain.com",
+			Hosts:  []string{"office"},
+			IPv4:   StaticResolver(netip.MustParseAddr("10.0.0.2")),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = updater.Update(context.Background())
+		assert.ErrorIs(t, err, namecheap.ErrZoneChanged)
+		assert.Equal(t, map[string][]string{"@": {"10.0.0.1"}, "office": {"10.0.0.1"}, "new": {"10.0.0.9"}}, hostAddresses(server, "A"))
+	})
+
+	t.Run("resolver_errors", func(t *testing.T) {
+		server := setupServer(t)
+
+		updater, _ := NewUpdater(server.Client(), Options{
+			Domain: "domain.com",
+			Hosts:  []string{"office"},
+			IPv4: ResolverFunc(func(ctx context.Context) (netip.Addr, error) {
+				return netip.Addr{}, errors.New("no route")
+			}),
+		})
+		_, err := updater.Update(context.Background())
+		assert.EqualError(t, err, "unable to discover the public address for the A records: no route")
+
+		updater, _ = NewUpdater(server.Client(), Options{
+			Domain: "domain.com",
+			Hosts:  []string{"office"},
+			IPv4:   StaticResolver(netip.MustParseAddr("2001:db8::1")),
+		})
+		_, err = updater.Update(context.Background())
+		assert.EqualError(t, err, `invalid public address "2001:db8::1" for the A records`)
+
+		assert.Empty(t, server.Requests())
+	})
+
+	t.Run("run", func(t *testing.T) {
+		server := setupServer(t)
+
+		var mu sync.Mutex
+		addr := netip.MustParseAddr("10.0.0.2")
+		var changes []Change
+		var errs []error
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		updater, err := NewUpdater(server.Client(), Options{
+			Domain:   "domain.com",
+			Hosts:    []string{"office"},
+			Interval: 10 * time.Millisecond,
+			IPv4: ResolverFunc(func(ctx context.Context) (netip.Addr, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				return addr, nil
+			}),
+			OnChange: func(change Change) {
+				mu.Lock()
+				defer mu.Unlock()
+				changes = append(changes, change)
+				if len(changes) == 1 {
+					addr = netip.MustParseAddr("10.0.0.3")
+				} else {
+					cancel()
+				}
+			},
+			OnError: func(err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				errs = append(errs, err)
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		server.FailNext("namecheap.domains.dns.getHosts", namecheaptest.Fault{Error: &namecheaptest.Error{Number: "2030166", Message: "Domain is invalid"}})
+
+		err = updater.Run(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Len(t, errs, 1)
+		assert.Len(t, changes, 2)
+		assert.Equal(t, "office A 10.0.0.2 => 10.0.0.3", changes[1].String())
+		assert.Equal(t, map[string][]string{"@": {"10.0.0.1"}, "office": {"10.0.0.3"}}, hostAddresses(server, "A"))
+	})
+}
@@ -0,0 +1,87 @@
+package ddns
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Public IP discovery services answering with the address of the caller as plain text
+const (
+	DefaultIPv4URL = "https://api.ipify.org"
+	DefaultIPv6URL = "https://api6.ipify.org"
+)
+
+// maxResolverResponse limits the response body read by HTTPResolver
+const maxResolverResponse = 1024
+
+// Resolver discovers the current public address of the network
+type Resolver interface {
+	PublicIP(ctx context.Context) (netip.Addr, error)
+}
+
+// ResolverFunc adapts a function to the Resolver interface, e.g. to read the address of a router interface
+type ResolverFunc func(ctx context.Context) (netip.Addr, error)
+
+// PublicIP calls f
+func (f ResolverFunc) PublicIP(ctx context.Context) (netip.Addr, error) {
+	return f(ctx)
+}
+
+// StaticResolver always returns the same address, e.g. for tests or addresses configured by hand
+type StaticResolver netip.Addr
+
+// PublicIP returns the address
+func (r StaticResolver) PublicIP(_ context.Context) (netip.Addr, error) {
+	return netip.Addr(r), nil
+}
+
+// HTTPResolver asks a web service answering with the address of the caller as plain text, e.g. DefaultIPv4URL
+type HTTPResolver struct {
+	URL string
+	// Client sends the requests, http.DefaultClient when nil
+	Client *http.Client
+}
+
+// NewHTTPResolver returns a resolver asking the service at url
+func NewHTTPResolver(url string) *HTTPResolver {
+	return &HTTPResolver{URL: url}
+}
+
+// PublicIP requests the address from the service
+func (r *HTTPResolver) PublicIP(ctx context.Context) (netip.Addr, error) {
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, r.URL, nil)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("error creating request: %w", err)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return netip.Addr{}, fmt.Errorf("unexpected response status from %s: %s", r.URL, response.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxResolverResponse))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(string(body)))
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid address from %s: %w", r.URL, err)
+	}
+
+	return addr.Unmap(), nil
+}
@@ -0,0 +1,47 @@
+package ddns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPResolver(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Status        int
+		Body          string
+		ExpectedAddr  netip.Addr
+		ExpectedError string
+	}{
+		{Name: "ipv4", Status: http.StatusOK, Body: "203.0.113.7\n", ExpectedAddr: netip.MustParseAddr("203.0.113.7")},
+		{Name: "ipv6", Status: http.StatusOK, Body: "2001:db8::1", ExpectedAddr: netip.MustParseAddr("2001:db8::1")},
+		{Name: "ipv4_mapped", Status: http.StatusOK, Body: "::ffff:203.0.113.7", ExpectedAddr: netip.MustParseAddr("203.0.113.7")},
+		{Name: "invalid", Status: http.StatusOK, Body: "<html>", ExpectedError: "invalid address from"},
+		{Name: "status", Status: http.StatusBadGateway, Body: "203.0.113.7", ExpectedError: "unexpected response status from"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+				writer.WriteHeader(c.Status)
+				_, _ = writer.Write([]byte(c.Body))
+			}))
+			defer mockServer.Close()
+
+			addr, err := NewHTTPResolver(mockServer.URL).PublicIP(context.Background())
+			if c.ExpectedError != "" {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), c.ExpectedError)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, c.ExpectedAddr, addr)
+		})
+	}
+}
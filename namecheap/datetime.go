@@ -1,6 +1,19 @@
 package namecheap
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// dateTimeLayouts are the formats of the dates returned by the API,
+// e.g. 11/26/2021 in domain details and 2021-11-26T09:15:00 in subscriptions
+var dateTimeLayouts = []string{
+	"01/02/2006",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05.999999999",
+	time.RFC3339Nano,
+	"1/2/2006 3:04:05 PM",
+}
 
 // DateTime represents a time that can be unmarshalled from an XML
 type DateTime struct {
@@ -11,13 +24,22 @@ func (dt DateTime) String() string {
 	return dt.Time.String()
 }
 
-func (dt *DateTime) UnmarshalText(text []byte) (err error) {
-	dt.Time, err = time.Parse("01/02/2006", string(text))
-	if err != nil {
-		return err
+// UnmarshalText parses any of the date formats used by the API, an empty value results in the zero time
+func (dt *DateTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		dt.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range dateTimeLayouts {
+		parsed, err := time.Parse(layout, string(text))
+		if err == nil {
+			dt.Time = parsed
+			return nil
+		}
 	}
 
-	return nil
+	return fmt.Errorf("invalid date value: %s", text)
 }
 
 // Equal reports whether dt and u are equal based on time.Equal
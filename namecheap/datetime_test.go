@@ -0,0 +1,41 @@
+package namecheap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTimeUnmarshalText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected time.Time
+	}{
+		{"date", "11/26/2021", time.Date(2021, 11, 26, 0, 0, 0, 0, time.UTC)},
+		{"iso_date_time", "2021-11-26T09:15:00", time.Date(2021, 11, 26, 9, 15, 0, 0, time.UTC)},
+		{"iso_date_time_fraction", "2021-11-26T09:15:00.25", time.Date(2021, 11, 26, 9, 15, 0, 250000000, time.UTC)},
+		{"rfc3339", "2021-11-26T09:15:00Z", time.Date(2021, 11, 26, 9, 15, 0, 0, time.UTC)},
+		{"us_date_time", "11/26/2021 9:15:00 AM", time.Date(2021, 11, 26, 9, 15, 0, 0, time.UTC)},
+		{"zero_date_time", "0001-01-01T00:00:00", time.Time{}},
+		{"empty", "", time.Time{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var dt DateTime
+			err := dt.UnmarshalText([]byte(testCase.text))
+
+			assert.Nil(t, err)
+			assert.True(t, testCase.expected.Equal(dt.Time), dt.Time.String())
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var dt DateTime
+		err := dt.UnmarshalText([]byte("tomorrow"))
+
+		assert.EqualError(t, err, "invalid date value: tomorrow")
+	})
+}
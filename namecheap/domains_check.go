@@ -1,36 +1,29 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 	"strings"
 )
 
-type CheckResponse struct {
-	XMLName         *xml.Name `xml:"ApiResponse"`
-	Errors          *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *CheckCommandResponse `xml:"CommandResponse"`
-}
+type CheckResponse = Response[CheckCommandResponse]
 
 type CheckCommandResponse struct {
 	DomainCheckResults *[]DomainCheckResult `xml:"DomainCheckResult"`
 }
 
 type DomainCheckResult struct {
-	Domain                    *string `xml:"Domain,attr"`
-	Available                 *string `xml:"Available,attr"`
-	ErrorNo                   *string `xml:"ErrorNo,attr"`
-	Description               *string `xml:"Description,attr"`
-	IsPremiumName             *string `xml:"IsPremiumName,attr"`
-	PremiumRegistrationPrice  *string `xml:"PremiumRegistrationPrice,attr"`
-	PremiumRenewalPrice       *string `xml:"PremiumRenewalPrice,attr"`
-	PremiumRestorePrice       *string `xml:"PremiumRestorePrice,attr"`
-	PremiumTransferPrice      *string `xml:"PremiumTransferPrice,attr"`
-	IcannFee                  *string `xml:"IcannFee,attr"`
-	EapFee                    *string `xml:"EapFee,attr"`
+	Domain                   *string `xml:"Domain,attr"`
+	Available                *string `xml:"Available,attr"`
+	ErrorNo                  *string `xml:"ErrorNo,attr"`
+	Description              *string `xml:"Description,attr"`
+	IsPremiumName            *string `xml:"IsPremiumName,attr"`
+	PremiumRegistrationPrice *string `xml:"PremiumRegistrationPrice,attr"`
+	PremiumRenewalPrice      *string `xml:"PremiumRenewalPrice,attr"`
+	PremiumRestorePrice      *string `xml:"PremiumRestorePrice,attr"`
+	PremiumTransferPrice     *string `xml:"PremiumTransferPrice,attr"`
+	IcannFee                 *string `xml:"IcannFee,attr"`
+	EapFee                   *string `xml:"EapFee,attr"`
 }
 
 func (r DomainCheckResult) String() string {
@@ -53,22 +46,15 @@ func (r DomainCheckResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains/check/
 func (s *DomainsService) Check(domains []string) (*CheckCommandResponse, error) {
-	var response CheckResponse
+	return s.CheckContext(context.Background(), domains)
+}
 
+// CheckContext is like Check but uses the provided context for the request and any retries
+func (s *DomainsService) CheckContext(ctx context.Context, domains []string) (*CheckCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.check",
 		"DomainList": strings.Join(domains, ","),
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[CheckCommandResponse](ctx, s.client, params)
 }
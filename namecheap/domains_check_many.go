@@ -0,0 +1,254 @@
+package namecheap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// DomainsCheckMaxBatchSize is the largest number of domains sent in a single namecheap.domains.check request
+	DomainsCheckMaxBatchSize = 50
+	// DomainsCheckMaxListLength is the largest length of the DomainList param of a single request,
+	// it keeps the requests of long domain names within the size limits of the API
+	DomainsCheckMaxListLength = 2000
+	// DefaultCheckManyConcurrency is the default number of concurrent requests of CheckMany
+	DefaultCheckManyConcurrency = 4
+)
+
+// CheckManyOptions configures DomainsService.CheckMany
+type CheckManyOptions struct {
+	// BatchSize is the number of domains checked per request, at most DomainsCheckMaxBatchSize.
+	// Default value: DomainsCheckMaxBatchSize
+	BatchSize int
+	// Concurrency is the number of requests sent at the same time. Default value: DefaultCheckManyConcurrency
+	Concurrency int
+}
+
+// CheckManyError is returned by CheckMany when some domains could not be checked.
+// The results of the other domains are returned along with it.
+type CheckManyError struct {
+	// Errors holds the error of every domain which could not be checked, keyed by normalized domain
+	Errors map[string]error
+}
+
+func (e *CheckManyError) Error() string {
+	domains := make([]string, 0, len(e.Errors))
+	for domain := range e.Errors {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	if len(domains) == 1 {
+		return fmt.Sprintf("unable to check %s: %s", domains[0], e.Errors[domains[0]])
+	}
+	return fmt.Sprintf("unable to check %d domains, %s: %s", len(domains), domains[0], e.Errors[domains[0]])
+}
+
+// Unwrap returns the errors of the domains, so that errors.Is and errors.As match any of them
+func (e *CheckManyError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// CheckMany checks the availability of any number of domains. The domains are normalized (trimmed, lowercased,
+// without trailing dot) and deduplicated, split into batches accepted by the API and checked concurrently.
+// The requests go through the rate limiter and the retry policy of the client like any other request.
+//
+// When a batch fails, e.g. because one of its domains has an unsupported TLD, its domains are checked again one by one
+// to isolate the failure. The results are keyed by normalized domain. When some domains still could not be checked,
+// the results of the others are returned with a *CheckManyError.
+func (s *DomainsService) CheckMany(domains []string, options *CheckManyOptions) (map[string]DomainCheckResult, error) {
+	return s.CheckManyContext(context.Background(), domains, options)
+}
+
+// CheckManyContext is like CheckMany but uses the provided context for the requests and any retries
+func (s *DomainsService) CheckManyContext(ctx context.Context, domains []string, options *CheckManyOptions) (map[string]DomainCheckResult, error) {
+	batchSize, concurrency, err := checkManySettings(options)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &domainsChecker{
+		service:  s,
+		results:  map[string]DomainCheckResult{},
+		failures: map[string]error{},
+	}
+
+	var retries [][]string
+	checker.run(ctx, splitCheckBatches(normalizeCheckDomains(domains), batchSize), concurrency, func(batch []string, err error) {
+		if len(batch) == 1 || !retryCheckIndividually(err) {
+			for _, domain := range batch {
+				checker.failures[domain] = err
+			}
+			return
+		}
+		for _, domain := range batch {
+			retries = append(retries, []string{domain})
+		}
+	})
+
+	if len(retries) > 0 && ctx.Err() == nil {
+		checker.run(ctx, retries, concurrency, func(batch []string, err error) {
+			checker.failures[batch[0]] = err
+		})
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(checker.failures) > 0 {
+		return checker.results, &CheckManyError{Errors: checker.failures}
+	}
+
+	return checker.results, nil
+}
+
+func checkManySettings(options *CheckManyOptions) (batchSize, concurrency int, err error) {
+	batchSize, concurrency = DomainsCheckMaxBatchSize, DefaultCheckManyConcurrency
+	if options == nil {
+		return batchSize, concurrency, nil
+	}
+
+	if options.BatchSize < 0 || options.BatchSize > DomainsCheckMaxBatchSize {
+		return 0, 0, fmt.Errorf("invalid BatchSize %d: it must be between 1 and %d", options.BatchSize, DomainsCheckMaxBatchSize)
+	}
+	if options.BatchSize > 0 {
+		batchSize = options.BatchSize
+	}
+	if options.Concurrency < 0 {
+		return 0, 0, fmt.Errorf("invalid Concurrency %d: it must be positive", options.Concurrency)
+	}
+	if options.Concurrency > 0 {
+		concurrency = options.Concurrency
+	}
+
+	return batchSize, concurrency, nil
+}
+
+// domainsChecker collects the results and failures of the concurrent batches of CheckMany
+type domainsChecker struct {
+	service *DomainsService
+
+	mu       sync.Mutex
+	results  map[string]DomainCheckResult
+	failures map[string]error
+}
+
+// run checks the batches with at most concurrency requests at a time. onFailure is called with the lock held
+// for every batch whose request failed or whose domains are missing from the response.
+func (c *domainsChecker) run(ctx context.Context, batches [][]string, concurrency int, onFailure func(batch []string, err error)) {
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for _, batch := range batches {
+		select {
+		case <-ctx.Done():
+			return
+		case slots <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(batch []string) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			c.check(ctx, batch, onFailure)
+		}(batch)
+	}
+}
+
+func (c *domainsChecker) check(ctx context.Context, batch []string, onFailure func(batch []string, err error)) {
+	response, err := c.service.CheckContext(ctx, batch)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil {
+		if ctx.Err() == nil {
+			onFailure(batch, err)
+		}
+		return
+	}
+
+	if response.DomainCheckResults != nil {
+		for _, result := range *response.DomainCheckResults {
+			if result.Domain != nil {
+				c.results[normalizeCheckDomain(*result.Domain)] = result
+			}
+		}
+	}
+
+	var missing []string
+	for _, domain := range batch {
+		if _, ok := c.results[domain]; !ok {
+			missing = append(missing, domain)
+		}
+	}
+	if len(missing) > 0 {
+		onFailure(missing, fmt.Errorf("no result returned for %s", strings.Join(missing, ", ")))
+	}
+}
+
+// retryCheckIndividually reports whether the domains of a failed batch may succeed when checked one by one,
+// which is not the case when the credentials are rejected or the context is done
+func retryCheckIndividually(err error) bool {
+	return !IsAuthError(err) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+func normalizeCheckDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// normalizeCheckDomains normalizes the domains and drops the empty ones and the duplicates, keeping the order
+func normalizeCheckDomains(domains []string) []string {
+	seen := make(map[string]bool, len(domains))
+	normalized := make([]string, 0, len(domains))
+
+	for _, domain := range domains {
+		domain = normalizeCheckDomain(domain)
+		if domain == "" || seen[domain] {
+			continue
+		}
+		seen[domain] = true
+		normalized = append(normalized, domain)
+	}
+
+	return normalized
+}
+
+// splitCheckBatches splits the domains into batches of at most batchSize domains and DomainsCheckMaxListLength characters
+func splitCheckBatches(domains []string, batchSize int) [][]string {
+	var batches [][]string
+	var batch []string
+	length := 0
+
+	for _, domain := range domains {
+		added := len(domain)
+		if len(batch) > 0 {
+			added++ // comma separator
+		}
+
+		if len(batch) > 0 && (len(batch) == batchSize || length+added > DomainsCheckMaxListLength) {
+			batches = append(batches, batch)
+			batch, length, added = nil, 0, len(domain)
+		}
+
+		batch = append(batch, domain)
+		length += added
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
@@ -0,0 +1,242 @@
+package namecheap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// checkManyServer answers namecheap.domains.check: the domains of the .bad TLD fail the whole request,
+// auth.com fails it with an authentication error and missing.com is left out of the response
+type checkManyServer struct {
+	*httptest.Server
+
+	delay time.Duration
+
+	mu          sync.Mutex
+	batches     [][]string
+	inFlight    int
+	maxInFlight int
+}
+
+func newCheckManyServer(t *testing.T) *checkManyServer {
+	s := &checkManyServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *checkManyServer) serveHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, _ := io.ReadAll(request.Body)
+	query, _ := url.ParseQuery(string(body))
+	domains := strings.Split(query.Get("DomainList"), ",")
+
+	s.mu.Lock()
+	s.batches = append(s.batches, domains)
+	s.inFlight++
+	if s.inFlight > s.maxInFlight {
+		s.maxInFlight = s.inFlight
+	}
+	s.mu.Unlock()
+
+	time.Sleep(s.delay)
+
+	s.mu.Lock()
+	s.inFlight--
+	s.mu.Unlock()
+
+	var results strings.Builder
+	for _, domain := range domains {
+		switch {
+		case domain == "auth.com":
+			_, _ = writer.Write([]byte(checkManyErrorResponse(ErrorNumberAPIKeyInvalid, "API Key is invalid")))
+			return
+		case strings.HasSuffix(domain, ".bad"):
+			_, _ = writer.Write([]byte(checkManyErrorResponse("2030280", "TLD is not supported in API: bad")))
+			return
+		case domain == "missing.com":
+			continue
+		}
+
+		available := !strings.HasPrefix(domain, "taken")
+		results.WriteString(fmt.Sprintf(`<DomainCheckResult Domain="%s" Available="%t" ErrorNo="0" Description="" IsPremiumName="false" />`,
+			strings.ToUpper(domain), available))
+	}
+
+	_, _ = writer.Write([]byte(`
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<RequestedCommand>namecheap.domains.check</RequestedCommand>
+			<CommandResponse Type="namecheap.domains.check">` + results.String() + `</CommandResponse>
+		</ApiResponse>
+	`))
+}
+
+func checkManyErrorResponse(number, message string) string {
+	return `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="ERROR">
+			<Errors>
+				<Error Number="` + number + `">` + message + `</Error>
+			</Errors>
+			<RequestedCommand>namecheap.domains.check</RequestedCommand>
+		</ApiResponse>
+	`
+}
+
+func (s *checkManyServer) batchSizes() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sizes := make([]int, len(s.batches))
+	for i, batch := range s.batches {
+		sizes[i] = len(batch)
+	}
+	return sizes
+}
+
+func TestDomainsCheckMany(t *testing.T) {
+	domainNames := func(prefix string, count int) []string {
+		names := make([]string, count)
+		for i := range names {
+			names[i] = fmt.Sprintf("%s%d.com", prefix, i)
+		}
+		return names
+	}
+
+	t.Run("batches", func(t *testing.T) {
+		server := newCheckManyServer(t)
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		results, err := client.Domains.CheckMany(domainNames("name", 120), nil)
+
+		assert.Nil(t, err)
+		assert.Len(t, results, 120)
+		assert.ElementsMatch(t, []int{50, 50, 20}, server.batchSizes())
+		assert.Equal(t, "true", *results["name42.com"].Available)
+	})
+
+	t.Run("normalized_keys", func(t *testing.T) {
+		server := newCheckManyServer(t)
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		results, err := client.Domains.CheckMany([]string{" Domain.com ", "domain.com.", "TAKEN.net", ""}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"domain.com", "taken.net"}}, server.batches)
+		assert.Len(t, results, 2)
+		assert.Equal(t, "true", *results["domain.com"].Available)
+		assert.Equal(t, "false", *results["taken.net"].Available)
+		assert.Equal(t, "TAKEN.NET", *results["taken.net"].Domain)
+	})
+
+	t.Run("concurrency", func(t *testing.T) {
+		server := newCheckManyServer(t)
+		server.delay = 20 * time.Millisecond
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		results, err := client.Domains.CheckMany(domainNames("name", 40), &CheckManyOptions{BatchSize: 5, Concurrency: 2})
+
+		assert.Nil(t, err)
+		assert.Len(t, results, 40)
+		assert.Len(t, server.batches, 8)
+		assert.Equal(t, 2, server.maxInFlight)
+	})
+
+	t.Run("rate_limiter", func(t *testing.T) {
+		server := newCheckManyServer(t)
+		limiter := NewRateLimiter(RateLimiterOptions{PerMinute: 3})
+		client := setupClient(nil, WithBaseURL(server.URL), WithRateLimiter(limiter))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		_, err := client.Domains.CheckManyContext(ctx, domainNames("name", 50), &CheckManyOptions{BatchSize: 10, Concurrency: 5})
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Len(t, server.batches, 3)
+	})
+
+	t.Run("failed_batch_retried_individually", func(t *testing.T) {
+		server := newCheckManyServer(t)
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		domains := append(domainNames("name", 8), "domain.bad", "missing.com")
+		results, err := client.Domains.CheckMany(domains, &CheckManyOptions{BatchSize: 5})
+
+		assert.Len(t, results, 8)
+		assert.Contains(t, results, "name7.com")
+
+		var checkErr *CheckManyError
+		if assert.True(t, errors.As(err, &checkErr)) {
+			assert.Len(t, checkErr.Errors, 2)
+			assert.Contains(t, checkErr.Errors["domain.bad"].Error(), "TLD is not supported")
+			assert.Contains(t, checkErr.Errors["missing.com"].Error(), "no result returned for missing.com")
+		}
+		assert.Contains(t, err.Error(), "unable to check 2 domains, domain.bad")
+		assert.True(t, IsAPIErrorNumber(err, "2030280"))
+
+		// the batch of domain.bad and missing.com is checked again one by one
+		assert.ElementsMatch(t, []int{5, 5, 1, 1, 1, 1, 1}, server.batchSizes())
+	})
+
+	t.Run("auth_error_not_retried", func(t *testing.T) {
+		server := newCheckManyServer(t)
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		results, err := client.Domains.CheckMany([]string{"auth.com", "domain.com"}, nil)
+
+		assert.Empty(t, results)
+		assert.True(t, IsAuthError(err))
+		assert.Len(t, server.batches, 1)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		server := newCheckManyServer(t)
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		results, err := client.Domains.CheckMany(nil, nil)
+
+		assert.Nil(t, err)
+		assert.Empty(t, results)
+		assert.Empty(t, server.batches)
+	})
+
+	t.Run("invalid_options", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Domains.CheckMany([]string{"domain.com"}, &CheckManyOptions{BatchSize: 51})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "invalid BatchSize 51")
+		}
+
+		_, err = client.Domains.CheckMany([]string{"domain.com"}, &CheckManyOptions{Concurrency: -1})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "invalid Concurrency -1")
+		}
+	})
+}
+
+func TestSplitCheckBatches(t *testing.T) {
+	long := strings.Repeat("a", 900) + ".com"
+
+	batches := splitCheckBatches([]string{long, long, long, "b.com"}, 50)
+
+	assert.Len(t, batches, 2)
+	assert.Equal(t, []string{long, long}, batches[0])
+	assert.Equal(t, []string{long, "b.com"}, batches[1])
+	for _, batch := range batches {
+		assert.LessOrEqual(t, len(strings.Join(batch, ",")), DomainsCheckMaxListLength)
+	}
+}
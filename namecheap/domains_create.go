@@ -1,6 +1,7 @@
 package namecheap
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 )
@@ -32,6 +33,9 @@ type CreateArgs struct {
 	Tech       *ContactInfo
 	Admin      *ContactInfo
 	AuxBilling *ContactInfo
+	// AddressID is the ID of an address saved with UsersAddressService,
+	// it is used for the contacts which are not set
+	AddressID *int
 
 	AddFreeWhoisguard *bool
 	WGEnabled         *bool
@@ -45,13 +49,7 @@ type CreateArgs struct {
 	EapFee          *string
 }
 
-type DomainsCreateResponse struct {
-	Errors *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsCreateCommandResponse `xml:"CommandResponse"`
-}
+type DomainsCreateResponse = Response[DomainsCreateCommandResponse]
 
 type DomainsCreateCommandResponse struct {
 	DomainCreateResult *DomainsCreateResult `xml:"DomainCreateResult"`
@@ -256,12 +254,24 @@ func parseCreateArgs(args *CreateArgs) (*map[string]string, error) {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains/create/
 func (s *DomainsService) Create(args *CreateArgs) (*DomainsCreateCommandResponse, error) {
-	var response DomainsCreateResponse
+	return s.CreateContext(context.Background(), args)
+}
 
+// CreateContext is like Create but uses the provided context for the request and any retries
+func (s *DomainsService) CreateContext(ctx context.Context, args *CreateArgs) (*DomainsCreateCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.create",
 	}
 
+	if args != nil && args.AddressID != nil {
+		expanded := *args
+		err := (*UsersAddressService)(s).fillContacts(ctx, *args.AddressID, &expanded.Registrant, &expanded.Tech, &expanded.Admin, &expanded.AuxBilling)
+		if err != nil {
+			return nil, err
+		}
+		args = &expanded
+	}
+
 	parsedArgs, err := parseCreateArgs(args)
 	if err != nil {
 		return nil, err
@@ -270,15 +280,5 @@ func (s *DomainsService) Create(args *CreateArgs) (*DomainsCreateCommandResponse
 		params[k] = v
 	}
 
-	_, err = s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[DomainsCreateCommandResponse](ctx, s.client, params)
 }
@@ -9,5 +9,11 @@ package namecheap
 // DomainsDNSService.SetEmailForwarding - sets email forwarding for a domain name
 // DomainsDNSService.SetHosts - sets DNS host records settings for the requested domain
 //
+// DomainsDNSService.PlanZone, ApplyZone and ApplyPlan reconcile the host records of a domain with a desired Zone
+// on top of GetHosts and SetHosts, which otherwise replaces the entire record set.
+// DomainsDNSService.AddRecord, UpdateRecord, DeleteRecord and UpsertRecord change a single record the same way.
+//
+// Every method has a ...Context variant taking a context.Context as its first argument.
+//
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-dns/
 type DomainsDNSService service
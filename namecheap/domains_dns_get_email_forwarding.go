@@ -1,19 +1,9 @@
 package namecheap
 
-import (
-	"encoding/xml"
-	"fmt"
-)
+import "context"
 
 // GetEmailForwardingResponse represents the API response for getEmailForwarding
-type GetEmailForwardingResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *GetEmailForwardingCommandResponse `xml:"CommandResponse"`
-}
+type GetEmailForwardingResponse = Response[GetEmailForwardingCommandResponse]
 
 // GetEmailForwardingCommandResponse wraps the result
 type GetEmailForwardingCommandResponse struct {
@@ -28,29 +18,23 @@ type GetEmailForwardingResult struct {
 
 // EmailForwardingRule represents a single email forwarding rule
 type EmailForwardingRule struct {
-	Mailbox     *string `xml:"mailbox,attr"`
-	ForwardTo   *string `xml:",chardata"`
+	Mailbox   *string `xml:"mailbox,attr"`
+	ForwardTo *string `xml:",chardata"`
 }
 
 // GetEmailForwarding retrieves email forwarding settings for the requested domain.
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-dns/get-email-forwarding/
 func (dds *DomainsDNSService) GetEmailForwarding(domainName string) (*GetEmailForwardingCommandResponse, error) {
-	var response GetEmailForwardingResponse
+	return dds.GetEmailForwardingContext(context.Background(), domainName)
+}
 
+// GetEmailForwardingContext is like GetEmailForwarding but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) GetEmailForwardingContext(ctx context.Context, domainName string) (*GetEmailForwardingCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.dns.getEmailForwarding",
 		"DomainName": domainName,
 	}
 
-	_, err := dds.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[GetEmailForwardingCommandResponse](ctx, dds.client, params)
 }
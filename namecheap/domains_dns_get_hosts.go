@@ -1,18 +1,11 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
-type DomainsDNSGetHostsResponse struct {
-	XMLName xml.Name `xml:"ApiResponse"`
-	Errors  []struct {
-		Message string `xml:",chardata"`
-		Number  string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsDNSGetHostsCommandResponse `xml:"CommandResponse"`
-}
+type DomainsDNSGetHostsResponse = Response[DomainsDNSGetHostsCommandResponse]
 
 type DomainsDNSGetHostsCommandResponse struct {
 	DomainDNSGetHostsResult *DomainDNSGetHostsResult `xml:"DomainDNSGetHostsResult"`
@@ -47,8 +40,11 @@ func (d DomainsDNSHostRecordDetailed) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-dns/get-hosts/
 func (dds *DomainsDNSService) GetHosts(domain string) (*DomainsDNSGetHostsCommandResponse, error) {
-	var response DomainsDNSGetHostsResponse
+	return dds.GetHostsContext(context.Background(), domain)
+}
 
+// GetHostsContext is like GetHosts but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) GetHostsContext(ctx context.Context, domain string) (*DomainsDNSGetHostsCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.dns.getHosts",
 	}
@@ -61,14 +57,5 @@ func (dds *DomainsDNSService) GetHosts(domain string) (*DomainsDNSGetHostsComman
 	params["SLD"] = parsedDomain.SLD
 	params["TLD"] = parsedDomain.TLD
 
-	_, err = dds.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-	if len(response.Errors) > 0 {
-		apiErr := response.Errors[0]
-		return nil, fmt.Errorf("%s (%s)", apiErr.Message, apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[DomainsDNSGetHostsCommandResponse](ctx, dds.client, params)
 }
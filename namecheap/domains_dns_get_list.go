@@ -1,18 +1,11 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
-type DomainsDNSGetListResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsDNSGetListCommandResponse `xml:"CommandResponse"`
-}
+type DomainsDNSGetListResponse = Response[DomainsDNSGetListCommandResponse]
 
 type DomainsDNSGetListCommandResponse struct {
 	DomainDNSGetListResult *DomainDNSGetListResult `xml:"DomainDNSGetListResult"`
@@ -36,8 +29,11 @@ func (d DomainDNSGetListResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-dns/get-list/
 func (dds *DomainsDNSService) GetList(domain string) (*DomainsDNSGetListCommandResponse, error) {
-	var response DomainsDNSGetListResponse
+	return dds.GetListContext(context.Background(), domain)
+}
 
+// GetListContext is like GetList but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) GetListContext(ctx context.Context, domain string) (*DomainsDNSGetListCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.dns.getList",
 	}
@@ -50,19 +46,17 @@ func (dds *DomainsDNSService) GetList(domain string) (*DomainsDNSGetListCommandR
 	params["SLD"] = parsedDomain.SLD
 	params["TLD"] = parsedDomain.TLD
 
-	_, err = dds.client.DoXML(params, &response)
+	response, err := doCommand[DomainsDNSGetListCommandResponse](ctx, dds.client, params)
 	if err != nil {
-		return nil, err
-	}
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
+		apiErr := asAPIError(err)
 
-		if *apiErr.Number != "2019166" {
-			return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
+		// domains using FreeDNS are reported as not found, so their details are taken from getInfo
+		if apiErr == nil || apiErr.Number != ErrorNumberDomainNotFound {
+			return nil, err
 		}
 
 		var domainInfo *DomainsGetInfoCommandResponse
-		domainInfo, err = dds.client.Domains.GetInfo(domain)
+		domainInfo, err = dds.client.Domains.GetInfoContext(ctx, domain)
 		if err != nil {
 			return nil, err
 		}
@@ -80,5 +74,5 @@ func (dds *DomainsDNSService) GetList(domain string) (*DomainsDNSGetListCommandR
 		}, nil
 	}
 
-	return response.CommandResponse, nil
+	return response, nil
 }
@@ -0,0 +1,171 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+// AddRecord adds a host record to the domain and keeps the other records and the email type as they are.
+// The records are re-read before writing, ErrZoneChanged is returned when they were modified concurrently.
+// The returned plan holds the applied changes.
+func (dds *DomainsDNSService) AddRecord(domain string, record DomainsDNSHostRecord) (*ZonePlan, error) {
+	return dds.AddRecordContext(context.Background(), domain, record)
+}
+
+// AddRecordContext is like AddRecord but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) AddRecordContext(ctx context.Context, domain string, record DomainsDNSHostRecord) (*ZonePlan, error) {
+	return dds.modifyRecords(ctx, domain, func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error) {
+		if index := findRecord(records, record); index >= 0 {
+			return nil, fmt.Errorf("record %s already exists in %s", formatZoneRecord(&record), domain)
+		}
+
+		return append(records, record), nil
+	})
+}
+
+// UpdateRecord replaces the host record matching current by host name, record type and address with record.
+// TTL and MXPref of current are ignored when looking for the record.
+// The records are re-read before writing, ErrZoneChanged is returned when they were modified concurrently.
+func (dds *DomainsDNSService) UpdateRecord(domain string, current, record DomainsDNSHostRecord) (*ZonePlan, error) {
+	return dds.UpdateRecordContext(context.Background(), domain, current, record)
+}
+
+// UpdateRecordContext is like UpdateRecord but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) UpdateRecordContext(ctx context.Context, domain string, current, record DomainsDNSHostRecord) (*ZonePlan, error) {
+	return dds.modifyRecords(ctx, domain, func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error) {
+		index := findRecord(records, current)
+		if index < 0 {
+			return nil, fmt.Errorf("record %s not found in %s", formatZoneRecord(&current), domain)
+		}
+
+		records[index] = record
+		return records, nil
+	})
+}
+
+// DeleteRecord removes the host record matching record by host name, record type and address.
+// The records are re-read before writing, ErrZoneChanged is returned when they were modified concurrently.
+func (dds *DomainsDNSService) DeleteRecord(domain string, record DomainsDNSHostRecord) (*ZonePlan, error) {
+	return dds.DeleteRecordContext(context.Background(), domain, record)
+}
+
+// DeleteRecordContext is like DeleteRecord but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) DeleteRecordContext(ctx context.Context, domain string, record DomainsDNSHostRecord) (*ZonePlan, error) {
+	return dds.modifyRecords(ctx, domain, func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error) {
+		index := findRecord(records, record)
+		if index < 0 {
+			return nil, fmt.Errorf("record %s not found in %s", formatZoneRecord(&record), domain)
+		}
+
+		return append(records[:index], records[index+1:]...), nil
+	})
+}
+
+// UpsertRecord sets the TTL and MXPref of the host record with the same host name, record type and address,
+// replaces the record with the same host name and type when it is the only one, and adds the record otherwise.
+// Nothing is written when the record is already up to date.
+// The records are re-read before writing, ErrZoneChanged is returned when they were modified concurrently.
+func (dds *DomainsDNSService) UpsertRecord(domain string, record DomainsDNSHostRecord) (*ZonePlan, error) {
+	return dds.UpsertRecordContext(context.Background(), domain, record)
+}
+
+// UpsertRecordContext is like UpsertRecord but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) UpsertRecordContext(ctx context.Context, domain string, record DomainsDNSHostRecord) (*ZonePlan, error) {
+	return dds.modifyRecords(ctx, domain, func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error) {
+		if index := findRecord(records, record); index >= 0 {
+			records[index] = record
+			return records, nil
+		}
+
+		want := newZoneRecord(record)
+		index := -1
+		for i, have := range records {
+			if newZoneRecord(have).key() != want.key() {
+				continue
+			}
+			if index >= 0 {
+				return append(records, record), nil
+			}
+			index = i
+		}
+
+		if index >= 0 {
+			records[index] = record
+			return records, nil
+		}
+
+		return append(records, record), nil
+	})
+}
+
+// ModifyRecords fetches the host records of the domain, lets modify change them and applies the result
+// keeping the email type as it is. modify may change the passed slice in place.
+// The records are re-read before writing, ErrZoneChanged is returned when they were modified concurrently.
+// The returned plan holds the applied changes.
+func (dds *DomainsDNSService) ModifyRecords(domain string, modify func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error)) (*ZonePlan, error) {
+	return dds.ModifyRecordsContext(context.Background(), domain, modify)
+}
+
+// ModifyRecordsContext is like ModifyRecords but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) ModifyRecordsContext(ctx context.Context, domain string, modify func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error)) (*ZonePlan, error) {
+	return dds.modifyRecords(ctx, domain, modify)
+}
+
+// PlanRecords is like ModifyRecords but only computes the plan, e.g. for a dry run.
+// The plan is computed from the same read of the records as the result of modify, so ApplyPlan
+// fails with ErrZoneChanged instead of overwriting the records modified in between.
+func (dds *DomainsDNSService) PlanRecords(domain string, modify func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error)) (*ZonePlan, error) {
+	return dds.PlanRecordsContext(context.Background(), domain, modify)
+}
+
+// PlanRecordsContext is like PlanRecords but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) PlanRecordsContext(ctx context.Context, domain string, modify func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error)) (*ZonePlan, error) {
+	response, err := dds.GetHostsContext(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	result := response.DomainDNSGetHostsResult
+
+	var records []DomainsDNSHostRecord
+	if result != nil && result.Hosts != nil {
+		for _, host := range *result.Hosts {
+			records = append(records, host.HostRecord())
+		}
+	}
+
+	records, err = modify(records)
+	if err != nil {
+		return nil, err
+	}
+
+	return planZone(&Zone{Domain: domain, Records: records}, result)
+}
+
+// modifyRecords plans the records changed by modify with PlanRecords and applies the plan with ApplyPlan
+func (dds *DomainsDNSService) modifyRecords(ctx context.Context, domain string, modify func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error)) (*ZonePlan, error) {
+	plan, err := dds.PlanRecordsContext(ctx, domain, modify)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.IsEmpty() {
+		return plan, nil
+	}
+
+	return plan, dds.ApplyPlanContext(ctx, plan)
+}
+
+// findRecord returns the index of the record with the same host name, record type and address, or -1
+func findRecord(records []DomainsDNSHostRecord, record DomainsDNSHostRecord) int {
+	want := newZoneRecord(record)
+
+	for i, have := range records {
+		normalized := newZoneRecord(have)
+		if normalized.key() == want.key() && normalized.address == want.address {
+			return i
+		}
+	}
+
+	return -1
+}
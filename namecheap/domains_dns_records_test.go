@@ -0,0 +1,234 @@
+package namecheap
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainsDNSRecords(t *testing.T) {
+	t.Run("add_record", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.AddRecord("domain.net", DomainsDNSHostRecord{
+			HostName:   String("api"),
+			RecordType: String(RecordTypeA),
+			Address:    String("10.0.0.3"),
+		})
+		if err != nil {
+			t.Fatal("Unable to add record", err)
+		}
+
+		assert.Equal(t, `+ api 1800 A "10.0.0.3"`, plan.String())
+		assert.Len(t, setHostsBodies, 1)
+
+		sentBody := setHostsBodies[0]
+		assert.Equal(t, "MX", sentBody.Get("EmailType"))
+		assert.Equal(t, "www", sentBody.Get("HostName2"))
+		assert.Equal(t, "domain.net.", sentBody.Get("Address2"))
+		assert.Equal(t, "300", sentBody.Get("TTL3"))
+		assert.Equal(t, "20", sentBody.Get("MXPref4"))
+		assert.Equal(t, "", sentBody.Get("MXPref1"))
+		assert.Equal(t, "api", sentBody.Get("HostName5"))
+		assert.Equal(t, "10.0.0.3", sentBody.Get("Address5"))
+
+		_, err = client.DomainsDNS.AddRecord("domain.net", DomainsDNSHostRecord{
+			HostName:   String("@"),
+			RecordType: String(RecordTypeA),
+			Address:    String("10.0.0.1"),
+		})
+		assert.EqualError(t, err, `record @ 1800 A "10.0.0.1" already exists in domain.net`)
+	})
+
+	t.Run("update_record", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.UpdateRecord("domain.net",
+			DomainsDNSHostRecord{HostName: String("www"), RecordType: String(RecordTypeCNAME), Address: String("domain.net")},
+			DomainsDNSHostRecord{HostName: String("www"), RecordType: String(RecordTypeCNAME), Address: String("other.net"), TTL: Int(60)},
+		)
+		if err != nil {
+			t.Fatal("Unable to update record", err)
+		}
+
+		assert.Equal(t, `~ www 1800 CNAME "domain.net." => www 60 CNAME "other.net"`, plan.String())
+		assert.Len(t, setHostsBodies, 1)
+		assert.Equal(t, "other.net", setHostsBodies[0].Get("Address2"))
+		assert.Equal(t, "60", setHostsBodies[0].Get("TTL2"))
+
+		_, err = client.DomainsDNS.UpdateRecord("domain.net",
+			DomainsDNSHostRecord{HostName: String("ftp"), RecordType: String(RecordTypeA), Address: String("10.0.0.9")},
+			DomainsDNSHostRecord{HostName: String("ftp"), RecordType: String(RecordTypeA), Address: String("10.0.0.8")},
+		)
+		assert.EqualError(t, err, `record ftp 1800 A "10.0.0.9" not found in domain.net`)
+	})
+
+	t.Run("delete_record", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.DeleteRecord("domain.net", DomainsDNSHostRecord{
+			HostName:   String("@"),
+			RecordType: String(RecordTypeTXT),
+			Address:    String("v=spf1 -all"),
+		})
+		if err != nil {
+			t.Fatal("Unable to delete record", err)
+		}
+
+		assert.Equal(t, `- @ 300 TXT "v=spf1 -all"`, plan.String())
+		assert.Len(t, setHostsBodies, 1)
+		assert.Equal(t, "MX", setHostsBodies[0].Get("RecordType3"))
+		assert.Equal(t, "", setHostsBodies[0].Get("RecordType4"))
+	})
+
+	t.Run("delete_last_mx_record", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		_, err := client.DomainsDNS.DeleteRecord("domain.net", DomainsDNSHostRecord{
+			HostName:   String("mail"),
+			RecordType: String(RecordTypeMX),
+			Address:    String("mx.domain.net"),
+		})
+		assert.EqualError(t, err, "minimum 1 MX record required for MX EmailType")
+		assert.Empty(t, setHostsBodies)
+	})
+
+	t.Run("upsert_record", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.UpsertRecord("domain.net", DomainsDNSHostRecord{
+			HostName:   String("@"),
+			RecordType: String(RecordTypeA),
+			Address:    String("10.0.0.1"),
+		})
+		if err != nil {
+			t.Fatal("Unable to upsert record", err)
+		}
+		assert.True(t, plan.IsEmpty())
+		assert.Empty(t, setHostsBodies)
+
+		plan, err = client.DomainsDNS.UpsertRecord("domain.net", DomainsDNSHostRecord{
+			HostName:   String("@"),
+			RecordType: String(RecordTypeA),
+			Address:    String("10.0.0.2"),
+		})
+		if err != nil {
+			t.Fatal("Unable to upsert record", err)
+		}
+		assert.Equal(t, `~ @ 1800 A "10.0.0.1" => @ 1800 A "10.0.0.2"`, plan.String())
+
+		plan, err = client.DomainsDNS.UpsertRecord("domain.net", DomainsDNSHostRecord{
+			HostName:   String("_acme-challenge"),
+			RecordType: String(RecordTypeTXT),
+			Address:    String("token"),
+			TTL:        Int(60),
+		})
+		if err != nil {
+			t.Fatal("Unable to upsert record", err)
+		}
+		assert.Equal(t, `+ _acme-challenge 60 TXT "token"`, plan.String())
+		assert.Len(t, setHostsBodies, 2)
+	})
+
+	t.Run("modify_records", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.ModifyRecords("domain.net", func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error) {
+			assert.Len(t, records, 4)
+			records[0].Address = String("10.0.0.9")
+			return append(records[:2], records[3]), nil
+		})
+		if err != nil {
+			t.Fatal("Unable to modify records", err)
+		}
+
+		assert.Equal(t, "~ @ 1800 A \"10.0.0.1\" => @ 1800 A \"10.0.0.9\"\n- @ 300 TXT \"v=spf1 -all\"", plan.String())
+		assert.Len(t, setHostsBodies, 1)
+		assert.Equal(t, "10.0.0.9", setHostsBodies[0].Get("Address1"))
+	})
+
+	t.Run("plan_records", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.PlanRecords("domain.net", func(records []DomainsDNSHostRecord) ([]DomainsDNSHostRecord, error) {
+			return append(records, DomainsDNSHostRecord{HostName: String("api"), RecordType: String(RecordTypeA), Address: String("10.0.0.3")}), nil
+		})
+		if err != nil {
+			t.Fatal("Unable to plan records", err)
+		}
+
+		assert.Equal(t, `+ api 1800 A "10.0.0.3"`, plan.String())
+		assert.Empty(t, setHostsBodies)
+
+		err = client.DomainsDNS.ApplyPlan(plan)
+		assert.Nil(t, err)
+		assert.Len(t, setHostsBodies, 1)
+	})
+
+	t.Run("concurrent_modification", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+		getHostsCalls := 0
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies, func() {
+			getHostsCalls++
+			if getHostsCalls == 2 {
+				hosts += `<host HostId="5" Name="new" Type="A" Address="10.0.0.5" MXPref="10" TTL="1800" IsActive="true" />`
+			}
+		})
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		_, err := client.DomainsDNS.AddRecord("domain.net", DomainsDNSHostRecord{
+			HostName:   String("api"),
+			RecordType: String(RecordTypeA),
+			Address:    String("10.0.0.3"),
+		})
+		assert.ErrorIs(t, err, ErrZoneChanged)
+		assert.Empty(t, setHostsBodies)
+	})
+}
@@ -1,19 +1,12 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 	"strings"
 )
 
-type DomainsDNSSetCustomResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsDNSSetCustomCommandResponse `xml:"CommandResponse"`
-}
+type DomainsDNSSetCustomResponse = Response[DomainsDNSSetCustomCommandResponse]
 
 type DomainsDNSSetCustomCommandResponse struct {
 	DomainDNSSetCustomResult *DomainsDNSSetCustomResult `xml:"DomainDNSSetCustomResult"`
@@ -33,8 +26,11 @@ func (d DomainsDNSSetCustomResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-dns/set-custom/
 func (dds *DomainsDNSService) SetCustom(domain string, nameservers []string) (*DomainsDNSSetCustomCommandResponse, error) {
-	var response DomainsDNSSetCustomResponse
+	return dds.SetCustomContext(context.Background(), domain, nameservers)
+}
 
+// SetCustomContext is like SetCustom but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) SetCustomContext(ctx context.Context, domain string, nameservers []string) (*DomainsDNSSetCustomCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.dns.setCustom",
 	}
@@ -54,16 +50,7 @@ func (dds *DomainsDNSService) SetCustom(domain string, nameservers []string) (*D
 
 	params["Nameservers"] = *nameserversString
 
-	_, err = dds.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[DomainsDNSSetCustomCommandResponse](ctx, dds.client, params)
 }
 
 func validateAndParseCustomNameservers(nameservers []string) (*string, error) {
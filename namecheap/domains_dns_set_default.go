@@ -1,18 +1,11 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
-type DomainsDNSSetDefaultResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsDNSSetDefaultCommandResponse `xml:"CommandResponse"`
-}
+type DomainsDNSSetDefaultResponse = Response[DomainsDNSSetDefaultCommandResponse]
 
 type DomainsDNSSetDefaultCommandResponse struct {
 	DomainDNSSetDefaultResult *DomainDNSSetDefaultResult `xml:"DomainDNSSetDefaultResult"`
@@ -32,8 +25,11 @@ func (d DomainDNSSetDefaultResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-dns/set-default/
 func (dds *DomainsDNSService) SetDefault(domain string) (*DomainsDNSSetDefaultCommandResponse, error) {
-	var response DomainsDNSSetDefaultResponse
+	return dds.SetDefaultContext(context.Background(), domain)
+}
 
+// SetDefaultContext is like SetDefault but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) SetDefaultContext(ctx context.Context, domain string) (*DomainsDNSSetDefaultCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.dns.setDefault",
 	}
@@ -46,14 +42,5 @@ func (dds *DomainsDNSService) SetDefault(domain string) (*DomainsDNSSetDefaultCo
 	params["SLD"] = parsedDomain.SLD
 	params["TLD"] = parsedDomain.TLD
 
-	_, err = dds.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[DomainsDNSSetDefaultCommandResponse](ctx, dds.client, params)
 }
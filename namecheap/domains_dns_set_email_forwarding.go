@@ -1,20 +1,13 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 	"strconv"
 )
 
 // SetEmailForwardingResponse represents the API response for setEmailForwarding
-type SetEmailForwardingResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *SetEmailForwardingCommandResponse `xml:"CommandResponse"`
-}
+type SetEmailForwardingResponse = Response[SetEmailForwardingCommandResponse]
 
 // SetEmailForwardingCommandResponse wraps the result
 type SetEmailForwardingCommandResponse struct {
@@ -41,8 +34,11 @@ type EmailForwardingEntry struct {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-dns/set-email-forwarding/
 func (dds *DomainsDNSService) SetEmailForwarding(domainName string, forwardingRules []EmailForwardingEntry) (*SetEmailForwardingCommandResponse, error) {
-	var response SetEmailForwardingResponse
+	return dds.SetEmailForwardingContext(context.Background(), domainName, forwardingRules)
+}
 
+// SetEmailForwardingContext is like SetEmailForwarding but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) SetEmailForwardingContext(ctx context.Context, domainName string, forwardingRules []EmailForwardingEntry) (*SetEmailForwardingCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.dns.setEmailForwarding",
 		"DomainName": domainName,
@@ -54,14 +50,5 @@ func (dds *DomainsDNSService) SetEmailForwarding(domainName string, forwardingRu
 		params["ForwardTo"+index] = rule.ForwardTo
 	}
 
-	_, err := dds.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[SetEmailForwardingCommandResponse](ctx, dds.client, params)
 }
@@ -1,7 +1,7 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -75,14 +75,7 @@ type DomainsDNSSetHostsArgs struct {
 	Tag *string
 }
 
-type DomainsDNSSetHostsResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsDNSSetHostsCommandResponse `xml:"CommandResponse"`
-}
+type DomainsDNSSetHostsResponse = Response[DomainsDNSSetHostsCommandResponse]
 
 type DomainsDNSSetHostsCommandResponse struct {
 	DomainDNSSetHostsResult *DomainDNSSetHostsResult `xml:"DomainDNSSetHostsResult"`
@@ -101,8 +94,11 @@ func (d DomainDNSSetHostsResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-dns/set-hosts/
 func (dds DomainsDNSService) SetHosts(args *DomainsDNSSetHostsArgs) (*DomainsDNSSetHostsCommandResponse, error) {
-	var response DomainsDNSSetHostsResponse
+	return dds.SetHostsContext(context.Background(), args)
+}
 
+// SetHostsContext is like SetHosts but uses the provided context for the request and any retries
+func (dds DomainsDNSService) SetHostsContext(ctx context.Context, args *DomainsDNSSetHostsArgs) (*DomainsDNSSetHostsCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.dns.setHosts",
 	}
@@ -124,16 +120,7 @@ func (dds DomainsDNSService) SetHosts(args *DomainsDNSSetHostsArgs) (*DomainsDNS
 		params[k] = v
 	}
 
-	_, err = dds.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[DomainsDNSSetHostsCommandResponse](ctx, dds.client, params)
 }
 
 // nolint: gocyclo
@@ -1,6 +1,7 @@
 package namecheap
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -52,6 +53,29 @@ func TestDomainsDNSSetHosts(t *testing.T) {
 		assert.Equal(t, "namecheap.domains.dns.setHosts", sentBody.Get("Command"))
 	})
 
+	t.Run("request_with_cancelled_context", func(t *testing.T) {
+		called := false
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			called = true
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := client.DomainsDNS.SetHostsContext(ctx, &DomainsDNSSetHostsArgs{
+			Domain: String("domain.net"),
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, called)
+	})
+
 	t.Run("request_data_correct_args_mapping", func(t *testing.T) {
 		var sentBody url.Values
 
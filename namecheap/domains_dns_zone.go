@@ -0,0 +1,401 @@
+package namecheap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultTTL is the TTL applied by Namecheap to records set without one
+	DefaultTTL int = 1800
+	// DefaultMXPref is the MX preference reported by Namecheap for records set without one
+	DefaultMXPref int = 10
+
+	ZoneChangeAdd    ZoneChangeType = "add"
+	ZoneChangeRemove ZoneChangeType = "remove"
+	ZoneChangeUpdate ZoneChangeType = "update"
+)
+
+// ErrZoneChanged is returned when the host records of a domain changed between planning and applying the changes
+var ErrZoneChanged = errors.New("zone changed since the plan was computed")
+
+// Zone is the desired DNS state of a domain.
+// Records replace every host record of the domain, EmailType is kept as is when nil.
+type Zone struct {
+	Domain    string
+	EmailType *string
+	Records   []DomainsDNSHostRecord
+}
+
+type ZoneChangeType string
+
+// ZoneChange is a single record difference between the current and desired zone.
+// Before is nil for additions and After is nil for removals.
+type ZoneChange struct {
+	Type   ZoneChangeType
+	Before *DomainsDNSHostRecord
+	After  *DomainsDNSHostRecord
+}
+
+func (c ZoneChange) String() string {
+	switch c.Type {
+	case ZoneChangeAdd:
+		return "+ " + formatZoneRecord(c.After)
+	case ZoneChangeRemove:
+		return "- " + formatZoneRecord(c.Before)
+	default:
+		return "~ " + formatZoneRecord(c.Before) + " => " + formatZoneRecord(c.After)
+	}
+}
+
+// ZonePlan is the difference between the current and desired state of a zone computed by DomainsDNSService.PlanZone
+type ZonePlan struct {
+	Domain string
+	// Changes lists the record additions, removals and changes sorted by host name, record type and address
+	Changes []ZoneChange
+	// EmailTypeBefore and EmailTypeAfter differ when the email type of the domain changes
+	EmailTypeBefore string
+	EmailTypeAfter  string
+
+	records []DomainsDNSHostRecord
+	current []zoneRecord
+}
+
+// IsEmpty reports whether applying the plan would leave the zone unchanged
+func (p *ZonePlan) IsEmpty() bool {
+	return len(p.Changes) == 0 && p.EmailTypeBefore == p.EmailTypeAfter
+}
+
+// String returns the plan as a diff suitable for audit logs, one change per line
+func (p *ZonePlan) String() string {
+	var lines []string
+
+	if p.EmailTypeBefore != p.EmailTypeAfter {
+		lines = append(lines, fmt.Sprintf("~ EmailType %s => %s", p.EmailTypeBefore, p.EmailTypeAfter))
+	}
+
+	for _, change := range p.Changes {
+		lines = append(lines, change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// HostRecord converts the record returned by GetHosts to a record accepted by SetHosts
+func (d DomainsDNSHostRecordDetailed) HostRecord() DomainsDNSHostRecord {
+	record := DomainsDNSHostRecord{
+		HostName:   d.Name,
+		RecordType: d.Type,
+		Address:    d.Address,
+		TTL:        d.TTL,
+	}
+
+	if d.Type != nil && *d.Type == RecordTypeMX && d.MXPref != nil {
+		mxPref := uint8(*d.MXPref)
+		record.MXPref = &mxPref
+	}
+
+	return record
+}
+
+// PlanZone computes the changes needed to bring the DNS host records of zone.Domain to the desired state.
+// Server defaults such as the 1800 TTL are taken into account, so records matching them are not reported as changed.
+func (dds *DomainsDNSService) PlanZone(zone *Zone) (*ZonePlan, error) {
+	return dds.PlanZoneContext(context.Background(), zone)
+}
+
+// PlanZoneContext is like PlanZone but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) PlanZoneContext(ctx context.Context, zone *Zone) (*ZonePlan, error) {
+	if zone == nil {
+		return nil, fmt.Errorf("Zone is required")
+	}
+
+	response, err := dds.GetHostsContext(ctx, zone.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return planZone(zone, response.DomainDNSGetHostsResult)
+}
+
+// ApplyZone plans the zone and applies the plan with SetHosts when it is not empty.
+// The returned plan holds the applied changes.
+func (dds *DomainsDNSService) ApplyZone(zone *Zone) (*ZonePlan, error) {
+	return dds.ApplyZoneContext(context.Background(), zone)
+}
+
+// ApplyZoneContext is like ApplyZone but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) ApplyZoneContext(ctx context.Context, zone *Zone) (*ZonePlan, error) {
+	plan, err := dds.PlanZoneContext(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.IsEmpty() {
+		return plan, nil
+	}
+
+	return plan, dds.setZoneHosts(ctx, plan)
+}
+
+// ApplyPlan applies a plan previously computed by PlanZone.
+// It fails with ErrZoneChanged without changing anything when the records of the zone changed since the plan was computed.
+func (dds *DomainsDNSService) ApplyPlan(plan *ZonePlan) error {
+	return dds.ApplyPlanContext(context.Background(), plan)
+}
+
+// ApplyPlanContext is like ApplyPlan but uses the provided context for the request and any retries
+func (dds *DomainsDNSService) ApplyPlanContext(ctx context.Context, plan *ZonePlan) error {
+	if plan == nil {
+		return fmt.Errorf("ZonePlan is required")
+	}
+
+	if plan.IsEmpty() {
+		return nil
+	}
+
+	response, err := dds.GetHostsContext(ctx, plan.Domain)
+	if err != nil {
+		return err
+	}
+
+	current, emailType := currentZoneRecords(response.DomainDNSGetHostsResult)
+	if emailType != plan.EmailTypeBefore || !reflect.DeepEqual(sortedZoneRecords(current), sortedZoneRecords(plan.current)) {
+		return fmt.Errorf("%s: %w", plan.Domain, ErrZoneChanged)
+	}
+
+	return dds.setZoneHosts(ctx, plan)
+}
+
+func (dds *DomainsDNSService) setZoneHosts(ctx context.Context, plan *ZonePlan) error {
+	args := &DomainsDNSSetHostsArgs{
+		Domain:  String(plan.Domain),
+		Records: &plan.records,
+	}
+
+	if plan.EmailTypeAfter != "" {
+		args.EmailType = String(plan.EmailTypeAfter)
+	}
+
+	response, err := dds.SetHostsContext(ctx, args)
+	if err != nil {
+		return err
+	}
+
+	if response.DomainDNSSetHostsResult == nil || response.DomainDNSSetHostsResult.IsSuccess == nil || !*response.DomainDNSSetHostsResult.IsSuccess {
+		return fmt.Errorf("failed to set hosts of %s", plan.Domain)
+	}
+
+	return nil
+}
+
+// zoneRecord is a host record normalized for comparison
+type zoneRecord struct {
+	hostName   string
+	recordType string
+	address    string
+	mxPref     int
+	ttl        int
+	// index in the desired records or in the hosts returned by GetHosts
+	index int
+}
+
+func (r zoneRecord) key() string {
+	return r.hostName + " " + r.recordType
+}
+
+func planZone(zone *Zone, result *DomainDNSGetHostsResult) (*ZonePlan, error) {
+	current, emailType := currentZoneRecords(result)
+
+	plan := &ZonePlan{
+		Domain:          zone.Domain,
+		EmailTypeBefore: emailType,
+		EmailTypeAfter:  emailType,
+		records:         append([]DomainsDNSHostRecord{}, zone.Records...),
+		current:         current,
+	}
+
+	if zone.EmailType != nil {
+		plan.EmailTypeAfter = *zone.EmailType
+	}
+
+	args := &DomainsDNSSetHostsArgs{Domain: String(zone.Domain), Records: &plan.records}
+	if plan.EmailTypeAfter != "" {
+		args.EmailType = String(plan.EmailTypeAfter)
+	}
+
+	if err := validateDomainsDNSSetHostsArgs(args); err != nil {
+		return nil, err
+	}
+
+	desired := make([]zoneRecord, len(plan.records))
+	for i, record := range plan.records {
+		desired[i] = newZoneRecord(record)
+		desired[i].index = i
+	}
+
+	currentRecords := make([]DomainsDNSHostRecord, len(current))
+	for i, record := range current {
+		currentRecords[i] = result.hostRecord(record.index)
+	}
+
+	matchedCurrent := make([]bool, len(current))
+	matchedDesired := make([]bool, len(desired))
+
+	// identical records first, then records sharing the host name and type are reported as changed
+	for i, want := range desired {
+		for j, have := range current {
+			if !matchedCurrent[j] && want == withIndex(have, want.index) {
+				matchedCurrent[j] = true
+				matchedDesired[i] = true
+				break
+			}
+		}
+	}
+
+	for i, want := range desired {
+		if matchedDesired[i] {
+			continue
+		}
+		for j, have := range current {
+			if !matchedCurrent[j] && want.key() == have.key() && (want.address == have.address || uniqueZoneRecordKey(want.key(), desired, current)) {
+				matchedCurrent[j] = true
+				matchedDesired[i] = true
+				plan.Changes = append(plan.Changes, ZoneChange{Type: ZoneChangeUpdate, Before: &currentRecords[j], After: &plan.records[want.index]})
+				break
+			}
+		}
+	}
+
+	for i, want := range desired {
+		if !matchedDesired[i] {
+			plan.Changes = append(plan.Changes, ZoneChange{Type: ZoneChangeAdd, After: &plan.records[want.index]})
+		}
+	}
+
+	for j := range current {
+		if !matchedCurrent[j] {
+			plan.Changes = append(plan.Changes, ZoneChange{Type: ZoneChangeRemove, Before: &currentRecords[j]})
+		}
+	}
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		return zoneChangeSortKey(plan.Changes[i]) < zoneChangeSortKey(plan.Changes[j])
+	})
+
+	return plan, nil
+}
+
+func (r *DomainDNSGetHostsResult) hostRecord(index int) DomainsDNSHostRecord {
+	return (*r.Hosts)[index].HostRecord()
+}
+
+func currentZoneRecords(result *DomainDNSGetHostsResult) ([]zoneRecord, string) {
+	if result == nil {
+		return nil, ""
+	}
+
+	emailType := ""
+	if result.EmailType != nil {
+		emailType = *result.EmailType
+	}
+
+	var records []zoneRecord
+	if result.Hosts != nil {
+		for i, host := range *result.Hosts {
+			record := newZoneRecord(host.HostRecord())
+			record.index = i
+			records = append(records, record)
+		}
+	}
+
+	return records, emailType
+}
+
+func newZoneRecord(record DomainsDNSHostRecord) zoneRecord {
+	normalized := zoneRecord{
+		hostName:   strings.ToLower(stringValue(record.HostName)),
+		recordType: strings.ToUpper(stringValue(record.RecordType)),
+		address:    stringValue(record.Address),
+		ttl:        DefaultTTL,
+	}
+
+	if record.TTL != nil && *record.TTL != 0 {
+		normalized.ttl = *record.TTL
+	}
+
+	switch normalized.recordType {
+	case RecordTypeMX:
+		normalized.mxPref = DefaultMXPref
+		if record.MXPref != nil {
+			normalized.mxPref = int(*record.MXPref)
+		}
+		normalized.address = normalizeZoneHostAddress(normalized.address)
+	case RecordTypeCNAME, RecordTypeAlias, RecordTypeNS:
+		normalized.address = normalizeZoneHostAddress(normalized.address)
+	}
+
+	return normalized
+}
+
+// normalizeZoneHostAddress ignores the case and the trailing dot of host names, which GetHosts may report as fully qualified
+func normalizeZoneHostAddress(address string) string {
+	return strings.TrimSuffix(strings.ToLower(address), ".")
+}
+
+func withIndex(record zoneRecord, index int) zoneRecord {
+	record.index = index
+	return record
+}
+
+func uniqueZoneRecordKey(key string, desired, current []zoneRecord) bool {
+	count := 0
+	for _, record := range desired {
+		if record.key() == key {
+			count++
+		}
+	}
+	for _, record := range current {
+		if record.key() == key {
+			count++
+		}
+	}
+	return count == 2
+}
+
+func sortedZoneRecords(records []zoneRecord) []zoneRecord {
+	sorted := make([]zoneRecord, len(records))
+	for i, record := range records {
+		sorted[i] = withIndex(record, 0)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return fmt.Sprint(sorted[i]) < fmt.Sprint(sorted[j])
+	})
+	return sorted
+}
+
+func zoneChangeSortKey(change ZoneChange) string {
+	record := change.After
+	if record == nil {
+		record = change.Before
+	}
+	normalized := newZoneRecord(*record)
+	return normalized.hostName + " " + normalized.recordType + " " + normalized.address
+}
+
+func formatZoneRecord(record *DomainsDNSHostRecord) string {
+	normalized := newZoneRecord(*record)
+
+	fields := []string{stringValue(record.HostName), strconv.Itoa(normalized.ttl), normalized.recordType}
+	if normalized.recordType == RecordTypeMX {
+		fields = append(fields, strconv.Itoa(normalized.mxPref))
+	}
+	fields = append(fields, strconv.Quote(stringValue(record.Address)))
+
+	return strings.Join(fields, " ")
+}
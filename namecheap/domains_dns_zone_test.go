@@ -0,0 +1,229 @@
+package namecheap
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newZoneServer(hosts *string, emailType *string, setHostsBodies *[]url.Values, onGetHosts ...func()) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		query, _ := url.ParseQuery(string(body))
+
+		switch query.Get("Command") {
+		case "namecheap.domains.dns.getHosts":
+			for _, hook := range onGetHosts {
+				hook()
+			}
+			_, _ = fmt.Fprintf(writer, `
+				<?xml version="1.0" encoding="utf-8"?>
+				<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+					<Errors />
+					<Warnings />
+					<RequestedCommand>namecheap.domains.dns.gethosts</RequestedCommand>
+					<CommandResponse Type="namecheap.domains.dns.getHosts">
+						<DomainDNSGetHostsResult Domain="domain.net" EmailType="%s" IsUsingOurDNS="true">
+							%s
+						</DomainDNSGetHostsResult>
+					</CommandResponse>
+					<Server>PHX01SBAPIEXT05</Server>
+					<GMTTimeDifference>--4:00</GMTTimeDifference>
+					<ExecutionTime>0.011</ExecutionTime>
+				</ApiResponse>
+			`, *emailType, *hosts)
+		case "namecheap.domains.dns.setHosts":
+			*setHostsBodies = append(*setHostsBodies, query)
+			_, _ = writer.Write([]byte(`
+				<?xml version="1.0" encoding="utf-8"?>
+				<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+					<Errors />
+					<Warnings />
+					<RequestedCommand>namecheap.domains.dns.sethosts</RequestedCommand>
+					<CommandResponse Type="namecheap.domains.dns.setHosts">
+						<DomainDNSSetHostsResult Domain="domain.net" IsSuccess="true" />
+					</CommandResponse>
+					<Server>PHX01SBAPIEXT05</Server>
+					<GMTTimeDifference>--4:00</GMTTimeDifference>
+					<ExecutionTime>0.011</ExecutionTime>
+				</ApiResponse>
+			`))
+		}
+	}))
+}
+
+const zoneTestHosts = `
+	<host HostId="1" Name="@" Type="A" Address="10.0.0.1" MXPref="10" TTL="1800" AssociatedAppTitle="" FriendlyName="" IsActive="true" IsDDNSEnabled="false" />
+	<host HostId="2" Name="www" Type="CNAME" Address="domain.net." MXPref="10" TTL="1800" AssociatedAppTitle="" FriendlyName="" IsActive="true" IsDDNSEnabled="false" />
+	<host HostId="3" Name="@" Type="TXT" Address="v=spf1 -all" MXPref="10" TTL="300" AssociatedAppTitle="" FriendlyName="" IsActive="true" IsDDNSEnabled="false" />
+	<host HostId="4" Name="mail" Type="MX" Address="mx.domain.net." MXPref="20" TTL="1800" AssociatedAppTitle="" FriendlyName="" IsActive="true" IsDDNSEnabled="false" />
+`
+
+func zoneTestRecords() []DomainsDNSHostRecord {
+	mxPref := uint8(20)
+
+	return []DomainsDNSHostRecord{
+		{HostName: String("@"), RecordType: String(RecordTypeA), Address: String("10.0.0.1")},
+		{HostName: String("WWW"), RecordType: String(RecordTypeCNAME), Address: String("domain.net")},
+		{HostName: String("@"), RecordType: String(RecordTypeTXT), Address: String("v=spf1 -all"), TTL: Int(300)},
+		{HostName: String("mail"), RecordType: String(RecordTypeMX), Address: String("mx.domain.net"), MXPref: &mxPref},
+	}
+}
+
+func TestDomainsDNSZone(t *testing.T) {
+	t.Run("empty_plan", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.ApplyZone(&Zone{Domain: "domain.net", Records: zoneTestRecords()})
+		if err != nil {
+			t.Fatal("Unable to apply zone", err)
+		}
+
+		assert.True(t, plan.IsEmpty())
+		assert.Equal(t, "", plan.String())
+		assert.Empty(t, setHostsBodies)
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		records := zoneTestRecords()
+		records[0].Address = String("10.0.0.2")
+		records[2].TTL = nil
+		records = append(records[:1], records[2:]...)
+		records = append(records, DomainsDNSHostRecord{HostName: String("api"), RecordType: String(RecordTypeAAAA), Address: String("::1"), TTL: Int(60)})
+
+		plan, err := client.DomainsDNS.ApplyZone(&Zone{Domain: "domain.net", Records: records})
+		if err != nil {
+			t.Fatal("Unable to apply zone", err)
+		}
+
+		assert.False(t, plan.IsEmpty())
+		assert.Equal(t, []ZoneChangeType{ZoneChangeUpdate, ZoneChangeUpdate, ZoneChangeAdd, ZoneChangeRemove}, []ZoneChangeType{
+			plan.Changes[0].Type, plan.Changes[1].Type, plan.Changes[2].Type, plan.Changes[3].Type,
+		})
+		assert.Equal(t, `~ @ 1800 A "10.0.0.1" => @ 1800 A "10.0.0.2"
+~ @ 300 TXT "v=spf1 -all" => @ 1800 TXT "v=spf1 -all"
++ api 60 AAAA "::1"
+- www 1800 CNAME "domain.net."`, plan.String())
+
+		assert.Len(t, setHostsBodies, 1)
+		sentBody := setHostsBodies[0]
+		assert.Equal(t, "MX", sentBody.Get("EmailType"))
+		assert.Equal(t, "10.0.0.2", sentBody.Get("Address1"))
+		assert.Equal(t, "TXT", sentBody.Get("RecordType2"))
+		assert.Equal(t, "", sentBody.Get("TTL2"))
+		assert.Equal(t, "20", sentBody.Get("MXPref3"))
+		assert.Equal(t, "AAAA", sentBody.Get("RecordType4"))
+		assert.Equal(t, "", sentBody.Get("RecordType5"))
+	})
+
+	t.Run("email_type", func(t *testing.T) {
+		hosts, emailType := `<host HostId="1" Name="@" Type="A" Address="10.0.0.1" MXPref="10" TTL="1800" IsActive="true" />`, "FWD"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.PlanZone(&Zone{
+			Domain:    "domain.net",
+			EmailType: String(EmailTypeNone),
+			Records:   zoneTestRecords()[:1],
+		})
+		if err != nil {
+			t.Fatal("Unable to plan zone", err)
+		}
+
+		assert.False(t, plan.IsEmpty())
+		assert.Empty(t, plan.Changes)
+		assert.Equal(t, "~ EmailType FWD => NONE", plan.String())
+	})
+
+	t.Run("apply_plan", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		records := zoneTestRecords()
+		records[0].TTL = Int(600)
+
+		plan, err := client.DomainsDNS.PlanZone(&Zone{Domain: "domain.net", Records: records})
+		if err != nil {
+			t.Fatal("Unable to plan zone", err)
+		}
+		assert.Len(t, plan.Changes, 1)
+		assert.Empty(t, setHostsBodies)
+
+		err = client.DomainsDNS.ApplyPlan(plan)
+		if err != nil {
+			t.Fatal("Unable to apply plan", err)
+		}
+		assert.Len(t, setHostsBodies, 1)
+		assert.Equal(t, "600", setHostsBodies[0].Get("TTL1"))
+	})
+
+	t.Run("apply_plan_after_zone_changed", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		plan, err := client.DomainsDNS.PlanZone(&Zone{Domain: "domain.net", Records: zoneTestRecords()[:1], EmailType: String(EmailTypeNone)})
+		if err != nil {
+			t.Fatal("Unable to plan zone", err)
+		}
+
+		hosts += `<host HostId="5" Name="new" Type="A" Address="10.0.0.5" MXPref="10" TTL="1800" IsActive="true" />`
+
+		err = client.DomainsDNS.ApplyPlan(plan)
+		assert.ErrorIs(t, err, ErrZoneChanged)
+		assert.EqualError(t, err, "domain.net: zone changed since the plan was computed")
+		assert.Empty(t, setHostsBodies)
+	})
+
+	t.Run("invalid_records", func(t *testing.T) {
+		hosts, emailType := zoneTestHosts, "MX"
+		var setHostsBodies []url.Values
+
+		mockServer := newZoneServer(&hosts, &emailType, &setHostsBodies)
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		_, err := client.DomainsDNS.ApplyZone(&Zone{
+			Domain:  "domain.net",
+			Records: []DomainsDNSHostRecord{{HostName: String("@"), RecordType: String(RecordTypeA)}},
+		})
+		assert.EqualError(t, err, "Records[0].Address is required")
+		assert.Empty(t, setHostsBodies)
+
+		_, err = client.DomainsDNS.ApplyZone(nil)
+		assert.EqualError(t, err, "Zone is required")
+	})
+}
@@ -1,18 +1,11 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
-type DomainsGetContactsResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsGetContactsCommandResponse `xml:"CommandResponse"`
-}
+type DomainsGetContactsResponse = Response[DomainsGetContactsCommandResponse]
 
 type DomainsGetContactsCommandResponse struct {
 	DomainContactsResult *DomainsGetContactsResult `xml:"DomainContactsResult"`
@@ -83,22 +76,15 @@ func stringValue(s *string) string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains/get-contacts/
 func (s *DomainsService) GetContacts(domain string) (*DomainsGetContactsCommandResponse, error) {
-	var response DomainsGetContactsResponse
+	return s.GetContactsContext(context.Background(), domain)
+}
 
+// GetContactsContext is like GetContacts but uses the provided context for the request and any retries
+func (s *DomainsService) GetContactsContext(ctx context.Context, domain string) (*DomainsGetContactsCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.getContacts",
 		"DomainName": domain,
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[DomainsGetContactsCommandResponse](ctx, s.client, params)
 }
@@ -1,58 +1,89 @@
 package namecheap
 
-import (
-	"encoding/xml"
-	"fmt"
-)
+import "context"
 
-type DomainsGetInfoResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsGetInfoCommandResponse `xml:"CommandResponse"`
-}
+type DomainsGetInfoResponse = Response[DomainsGetInfoCommandResponse]
 
 type DomainsGetInfoCommandResponse struct {
 	DomainDNSGetListResult *DomainsGetInfoResult `xml:"DomainGetInfoResult"`
 }
 
 type DomainsGetInfoResult struct {
+	Status                 *string                 `xml:"Status,attr"`
+	ID                     *int                    `xml:"ID,attr"`
 	DomainName             *string                 `xml:"DomainName,attr"`
+	OwnerName              *string                 `xml:"OwnerName,attr"`
+	IsOwner                *bool                   `xml:"IsOwner,attr"`
 	IsPremium              *bool                   `xml:"IsPremium,attr"`
+	DomainDetails          *DomainInfoDetails      `xml:"DomainDetails"`
+	LockDetails            *DomainLockDetails      `xml:"LockDetails"`
+	Whoisguard             *DomainWhoisguard       `xml:"Whoisguard"`
 	PremiumDnsSubscription *PremiumDnsSubscription `xml:"PremiumDnsSubscription"` // nolint: stylecheck,revive
 	DnsDetails             *DnsDetails             `xml:"DnsDetails"`             // nolint: stylecheck,revive
+	Modificationrights     *ModificationRights     `xml:"Modificationrights"`
+}
+
+type DomainInfoDetails struct {
+	CreatedDate *DateTime `xml:"CreatedDate"`
+	ExpiredDate *DateTime `xml:"ExpiredDate"`
+	NumYears    *int      `xml:"NumYears"`
+}
+
+// DomainLockDetails holds the raw content of the LockDetails element, the API doesn't document its structure
+type DomainLockDetails struct {
+	InnerXML string `xml:",innerxml"`
+}
+
+type DomainWhoisguard struct {
+	// Enabled is one of True, False or NotAlloted
+	Enabled      *string                       `xml:"Enabled,attr"`
+	ID           *int                          `xml:"ID"`
+	ExpiredDate  *DateTime                     `xml:"ExpiredDate"`
+	EmailDetails *DomainWhoisguardEmailDetails `xml:"EmailDetails"`
+}
+
+type DomainWhoisguardEmailDetails struct {
+	WhoisGuardEmail              *string   `xml:"WhoisGuardEmail,attr"`
+	ForwardedTo                  *string   `xml:"ForwardedTo,attr"`
+	LastAutoEmailChangeDate      *DateTime `xml:"LastAutoEmailChangeDate,attr"`
+	AutoEmailChangeFrequencyDays *int      `xml:"AutoEmailChangeFrequencyDays,attr"`
 }
 
 type PremiumDnsSubscription struct { // nolint: stylecheck,revive
-	IsActive *bool `xml:"IsActive"`
+	UseAutoRenew   *bool     `xml:"UseAutoRenew"`
+	SubscriptionId *int      `xml:"SubscriptionId"` // nolint: stylecheck,revive
+	CreatedDate    *DateTime `xml:"CreatedDate"`
+	ExpirationDate *DateTime `xml:"ExpirationDate"`
+	IsActive       *bool     `xml:"IsActive"`
 }
 
 type DnsDetails struct { // nolint: stylecheck,revive
-	ProviderType  *string   `xml:"ProviderType,attr"`
-	IsUsingOurDNS *bool     `xml:"IsUsingOurDNS,attr"`
-	Nameservers   *[]string `xml:"Nameserver"`
+	ProviderType     *string   `xml:"ProviderType,attr"`
+	IsUsingOurDNS    *bool     `xml:"IsUsingOurDNS,attr"`
+	HostCount        *int      `xml:"HostCount,attr"`
+	EmailType        *string   `xml:"EmailType,attr"`
+	DynamicDNSStatus *bool     `xml:"DynamicDNSStatus,attr"`
+	IsFailover       *bool     `xml:"IsFailover,attr"`
+	Nameservers      *[]string `xml:"Nameserver"`
+}
+
+type ModificationRights struct {
+	All *bool `xml:"All,attr"`
 }
 
+// GetInfo returns information about the requested domain
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/domains/get-info/
 func (ds *DomainsService) GetInfo(domain string) (*DomainsGetInfoCommandResponse, error) {
-	var response DomainsGetInfoResponse
+	return ds.GetInfoContext(context.Background(), domain)
+}
 
+// GetInfoContext is like GetInfo but uses the provided context for the request and any retries
+func (ds *DomainsService) GetInfoContext(ctx context.Context, domain string) (*DomainsGetInfoCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.getInfo",
 		"DomainName": domain,
-		"HostName":   domain,
-	}
-
-	_, err := ds.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
 	}
 
-	return response.CommandResponse, nil
+	return doCommand[DomainsGetInfoCommandResponse](ctx, ds.client, params)
 }
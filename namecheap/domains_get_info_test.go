@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -72,6 +73,122 @@ func TestDomainsGetInfo(t *testing.T) {
 		assert.Equal(t, "namecheap.domains.getInfo", sentBody.Get("Command"))
 	})
 
+	t.Run("request_data_domain", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Domains.GetInfo("horse-family.com.ua")
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+
+		assert.Equal(t, "horse-family.com.ua", sentBody.Get("DomainName"))
+		assert.False(t, sentBody.Has("HostName"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		fakeLocalResponse := `
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+				<Errors />
+				<Warnings />
+				<RequestedCommand>namecheap.domains.getinfo</RequestedCommand>
+				<CommandResponse Type="namecheap.domains.getInfo">
+					<DomainGetInfoResult Status="Ok" ID="57582" DomainName="example.com" OwnerName="anUser" IsOwner="true" IsPremium="false">
+						<DomainDetails>
+							<CreatedDate>02/15/2016</CreatedDate>
+							<ExpiredDate>02/15/2022</ExpiredDate>
+							<NumYears>0</NumYears>
+						</DomainDetails>
+						<LockDetails />
+						<Whoisguard Enabled="True">
+							<ID>53536</ID>
+							<ExpiredDate>11/04/2022</ExpiredDate>
+							<EmailDetails WhoisGuardEmail="abc@whoisguard.com" ForwardedTo="test@example.com" LastAutoEmailChangeDate="" AutoEmailChangeFrequencyDays="0" />
+						</Whoisguard>
+						<PremiumDnsSubscription>
+							<UseAutoRenew>true</UseAutoRenew>
+							<SubscriptionId>1234</SubscriptionId>
+							<CreatedDate>2021-03-10T08:15:30</CreatedDate>
+							<ExpirationDate>2022-03-10T08:15:30</ExpirationDate>
+							<IsActive>true</IsActive>
+						</PremiumDnsSubscription>
+						<DnsDetails ProviderType="CUSTOM" IsUsingOurDNS="false" HostCount="2" EmailType="MX" DynamicDNSStatus="false" IsFailover="false">
+							<Nameserver>ns1.example.net</Nameserver>
+							<Nameserver>ns2.example.net</Nameserver>
+						</DnsDetails>
+						<Modificationrights All="true" />
+					</DomainGetInfoResult>
+				</CommandResponse>
+				<Server>PHX01APIEXT12</Server>
+				<GMTTimeDifference>--5:00</GMTTimeDifference>
+				<ExecutionTime>0.013</ExecutionTime>
+			</ApiResponse>
+		`
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeLocalResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Domains.GetInfo("example.com")
+		if err != nil {
+			t.Fatal("Unable to get domain info", err)
+		}
+
+		result := response.DomainDNSGetListResult
+
+		assert.Equal(t, "Ok", *result.Status)
+		assert.Equal(t, 57582, *result.ID)
+		assert.Equal(t, "example.com", *result.DomainName)
+		assert.Equal(t, "anUser", *result.OwnerName)
+		assert.True(t, *result.IsOwner)
+		assert.False(t, *result.IsPremium)
+
+		assert.Equal(t, time.Date(2016, 2, 15, 0, 0, 0, 0, time.UTC), result.DomainDetails.CreatedDate.Time)
+		assert.Equal(t, time.Date(2022, 2, 15, 0, 0, 0, 0, time.UTC), result.DomainDetails.ExpiredDate.Time)
+		assert.Equal(t, 0, *result.DomainDetails.NumYears)
+
+		assert.NotNil(t, result.LockDetails)
+
+		assert.Equal(t, "True", *result.Whoisguard.Enabled)
+		assert.Equal(t, 53536, *result.Whoisguard.ID)
+		assert.Equal(t, time.Date(2022, 11, 4, 0, 0, 0, 0, time.UTC), result.Whoisguard.ExpiredDate.Time)
+		assert.Equal(t, "abc@whoisguard.com", *result.Whoisguard.EmailDetails.WhoisGuardEmail)
+		assert.Equal(t, "test@example.com", *result.Whoisguard.EmailDetails.ForwardedTo)
+		assert.True(t, result.Whoisguard.EmailDetails.LastAutoEmailChangeDate.IsZero())
+		assert.Equal(t, 0, *result.Whoisguard.EmailDetails.AutoEmailChangeFrequencyDays)
+
+		assert.True(t, *result.PremiumDnsSubscription.UseAutoRenew)
+		assert.Equal(t, 1234, *result.PremiumDnsSubscription.SubscriptionId)
+		assert.Equal(t, time.Date(2021, 3, 10, 8, 15, 30, 0, time.UTC), result.PremiumDnsSubscription.CreatedDate.Time)
+		assert.Equal(t, time.Date(2022, 3, 10, 8, 15, 30, 0, time.UTC), result.PremiumDnsSubscription.ExpirationDate.Time)
+		assert.True(t, *result.PremiumDnsSubscription.IsActive)
+
+		assert.Equal(t, "CUSTOM", *result.DnsDetails.ProviderType)
+		assert.False(t, *result.DnsDetails.IsUsingOurDNS)
+		assert.Equal(t, 2, *result.DnsDetails.HostCount)
+		assert.Equal(t, "MX", *result.DnsDetails.EmailType)
+		assert.False(t, *result.DnsDetails.DynamicDNSStatus)
+		assert.False(t, *result.DnsDetails.IsFailover)
+		assert.Equal(t, []string{"ns1.example.net", "ns2.example.net"}, *result.DnsDetails.Nameservers)
+
+		assert.True(t, *result.Modificationrights.All)
+	})
+
 	t.Run("server_empty_response", func(t *testing.T) {
 		fakeLocalResponse := ""
 
@@ -1,7 +1,7 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 	"strconv"
 )
@@ -9,14 +9,7 @@ import (
 var allowedListTypeValues = []string{"ALL", "EXPIRING", "EXPIRED"}
 var allowedSortByValues = []string{"NAME", "NAME_DESC", "EXPIREDATE", "EXPIREDATE_DESC", "CREATEDATE", "CREATEDATE_DESC"}
 
-type DomainsGetListResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *DomainsGetListCommandResponse `xml:"CommandResponse"`
-}
+type DomainsGetListResponse = Response[DomainsGetListCommandResponse]
 
 type DomainsGetListCommandResponse struct {
 	Domains *[]Domain             `xml:"DomainGetListResult>Domain"`
@@ -73,7 +66,11 @@ type DomainsGetListArgs struct {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains/get-list/
 func (ds *DomainsService) GetList(args *DomainsGetListArgs) (*DomainsGetListCommandResponse, error) {
-	var domainsResponse DomainsGetListResponse
+	return ds.GetListContext(context.Background(), args)
+}
+
+// GetListContext is like GetList but uses the provided context for the request and any retries
+func (ds *DomainsService) GetListContext(ctx context.Context, args *DomainsGetListArgs) (*DomainsGetListCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.getList",
 	}
@@ -89,16 +86,7 @@ func (ds *DomainsService) GetList(args *DomainsGetListArgs) (*DomainsGetListComm
 		params[k] = v
 	}
 
-	_, err = ds.client.DoXML(params, &domainsResponse)
-	if err != nil {
-		return nil, err
-	}
-	if domainsResponse.Errors != nil && len(*domainsResponse.Errors) > 0 {
-		apiErr := (*domainsResponse.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return domainsResponse.CommandResponse, nil
+	return doCommand[DomainsGetListCommandResponse](ctx, ds.client, params)
 }
 
 func parseDomainsGetListArgs(args *DomainsGetListArgs) (*map[string]string, error) {
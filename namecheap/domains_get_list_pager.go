@@ -0,0 +1,222 @@
+package namecheap
+
+import (
+	"context"
+	"sync"
+)
+
+// DomainsGetListMaxPageSize is the largest page size accepted by namecheap.domains.getList
+const DomainsGetListMaxPageSize = 100
+
+// DomainsGetListPager walks all pages of namecheap.domains.getList for the given
+// ListType, SearchTerm and SortBy.
+//
+// The pager can either be driven page by page with More and NextPage, or walked
+// domain by domain with Domains, which returns an iterator compatible with
+// iter.Seq2[Domain, error]:
+//
+//	pager := client.Domains.NewGetListPager(&namecheap.DomainsGetListArgs{ListType: namecheap.String("EXPIRING")})
+//	for domain, err := range pager.Domains(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+type DomainsGetListPager struct {
+	// Prefetch is the number of pages fetched concurrently ahead of the consumer by Domains and All.
+	// Pages are still yielded in order. Zero disables prefetching.
+	Prefetch int
+
+	service    *DomainsService
+	listType   *string
+	searchTerm *string
+	sortBy     *string
+	pageSize   int
+
+	nextPage int
+	lastPage int
+	done     bool
+}
+
+type domainsGetListPage struct {
+	domains []Domain
+	paging  *DomainsGetListPaging
+	err     error
+}
+
+// NewGetListPager creates a pager over all domains matching the args.
+// When args.PageSize is nil the maximum page size of 100 is used, and when args.Page is set the walk starts from that page.
+func (ds *DomainsService) NewGetListPager(args *DomainsGetListArgs) *DomainsGetListPager {
+	pager := &DomainsGetListPager{
+		service:  ds,
+		pageSize: DomainsGetListMaxPageSize,
+		nextPage: 1,
+		lastPage: -1,
+	}
+
+	if args != nil {
+		pager.listType = args.ListType
+		pager.searchTerm = args.SearchTerm
+		pager.sortBy = args.SortBy
+
+		if args.PageSize != nil {
+			pager.pageSize = *args.PageSize
+		}
+		if args.Page != nil {
+			pager.nextPage = *args.Page
+		}
+	}
+
+	return pager
+}
+
+// More reports whether NextPage has more pages to return
+func (p *DomainsGetListPager) More() bool {
+	return !p.done
+}
+
+// NextPage fetches the next page of domains. It returns an empty page once all pages have been returned.
+func (p *DomainsGetListPager) NextPage(ctx context.Context) ([]Domain, error) {
+	if p.done {
+		return []Domain{}, nil
+	}
+
+	page := p.fetch(ctx, p.nextPage)
+	if page.err != nil {
+		return nil, page.err
+	}
+
+	p.advance(page)
+
+	return page.domains, nil
+}
+
+// Domains returns an iterator over every remaining domain of the list, fetching pages as the iteration goes.
+// The iteration stops after the first error, which is yielded with a zero Domain.
+// Breaking out of the iteration cancels the pages being prefetched.
+func (p *DomainsGetListPager) Domains(ctx context.Context) func(yield func(Domain, error) bool) {
+	return func(yield func(Domain, error) bool) {
+		for p.More() && (p.Prefetch <= 0 || p.lastPage < 0) {
+			domains, err := p.NextPage(ctx)
+			if err != nil {
+				yield(Domain{}, err)
+				return
+			}
+
+			for _, domain := range domains {
+				if !yield(domain, nil) {
+					return
+				}
+			}
+		}
+
+		if !p.More() {
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer func() {
+			cancel()
+			wg.Wait()
+		}()
+
+		var pending []chan domainsGetListPage
+		scheduled := p.nextPage
+
+		schedule := func() {
+			result := make(chan domainsGetListPage, 1)
+			pending = append(pending, result)
+
+			wg.Add(1)
+			go func(page int) {
+				defer wg.Done()
+				result <- p.fetch(ctx, page)
+			}(scheduled)
+
+			scheduled++
+		}
+
+		for scheduled <= p.lastPage && len(pending) < p.Prefetch {
+			schedule()
+		}
+
+		for len(pending) > 0 {
+			page := <-pending[0]
+			pending = pending[1:]
+
+			if page.err != nil {
+				yield(Domain{}, page.err)
+				return
+			}
+
+			p.advance(page)
+
+			if scheduled <= p.lastPage {
+				schedule()
+			}
+
+			for _, domain := range page.domains {
+				if !yield(domain, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All collects every remaining domain of the list
+func (p *DomainsGetListPager) All(ctx context.Context) ([]Domain, error) {
+	var domains []Domain
+	var iterErr error
+
+	p.Domains(ctx)(func(domain Domain, err error) bool {
+		if err != nil {
+			iterErr = err
+			return false
+		}
+		domains = append(domains, domain)
+		return true
+	})
+
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	return domains, nil
+}
+
+func (p *DomainsGetListPager) fetch(ctx context.Context, page int) domainsGetListPage {
+	response, err := p.service.GetListContext(ctx, &DomainsGetListArgs{
+		ListType:   p.listType,
+		SearchTerm: p.searchTerm,
+		SortBy:     p.sortBy,
+		Page:       Int(page),
+		PageSize:   Int(p.pageSize),
+	})
+	if err != nil {
+		return domainsGetListPage{err: err}
+	}
+
+	result := domainsGetListPage{domains: []Domain{}, paging: response.Paging}
+	if response.Domains != nil {
+		result.domains = *response.Domains
+	}
+
+	return result
+}
+
+// advance moves the pager past the fetched page and learns the number of pages from the paging information
+func (p *DomainsGetListPager) advance(page domainsGetListPage) {
+	if page.paging != nil && page.paging.TotalItems != nil {
+		p.lastPage = (*page.paging.TotalItems + p.pageSize - 1) / p.pageSize
+	}
+
+	p.nextPage++
+
+	if p.lastPage >= 0 {
+		p.done = p.nextPage > p.lastPage
+	} else {
+		p.done = len(page.domains) < p.pageSize
+	}
+}
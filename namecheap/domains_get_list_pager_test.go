@@ -0,0 +1,247 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newDomainsGetListPagerServer(totalItems int, withPaging bool, failPage int) (*httptest.Server, *[]domainsGetListPagerRequest, *sync.Mutex) {
+	var mu sync.Mutex
+	var requests []domainsGetListPagerRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_ = request.ParseForm()
+		page, _ := strconv.Atoi(request.Form.Get("Page"))
+		pageSize, _ := strconv.Atoi(request.Form.Get("PageSize"))
+
+		mu.Lock()
+		requests = append(requests, domainsGetListPagerRequest{page: page, pageSize: pageSize, listType: request.Form.Get("ListType")})
+		mu.Unlock()
+
+		if page == failPage {
+			_, _ = writer.Write([]byte(`
+				<?xml version="1.0" encoding="UTF-8"?>
+				<ApiResponse Status="ERROR" xmlns="http://api.namecheap.com/xml.response">
+					<Errors>
+						<Error Number="2011166">Page failed</Error>
+					</Errors>
+					<Warnings />
+					<RequestedCommand>namecheap.domains.getList</RequestedCommand>
+				</ApiResponse>
+			`))
+			return
+		}
+
+		var domains strings.Builder
+		for i := (page - 1) * pageSize; i < page*pageSize && i < totalItems; i++ {
+			fmt.Fprintf(&domains, `<Domain ID="%d" Name="domain%d.com" User="user" Created="02/15/2016" Expires="02/15/2022" IsExpired="false" IsLocked="false" AutoRenew="false" WhoisGuard="ENABLED" IsPremium="false" IsOurDNS="true"/>`, i, i)
+		}
+
+		paging := ""
+		if withPaging {
+			paging = fmt.Sprintf(`<Paging><TotalItems>%d</TotalItems><CurrentPage>%d</CurrentPage><PageSize>%d</PageSize></Paging>`, totalItems, page, pageSize)
+		}
+
+		_, _ = fmt.Fprintf(writer, `
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+				<Errors />
+				<Warnings />
+				<RequestedCommand>namecheap.domains.getList</RequestedCommand>
+				<CommandResponse Type="namecheap.domains.getList">
+					<DomainGetListResult>%s</DomainGetListResult>
+					%s
+				</CommandResponse>
+				<Server>PHX01SBAPIEXT05</Server>
+				<GMTTimeDifference>--4:00</GMTTimeDifference>
+				<ExecutionTime>0.011</ExecutionTime>
+			</ApiResponse>
+		`, domains.String(), paging)
+	}))
+
+	return server, &requests, &mu
+}
+
+type domainsGetListPagerRequest struct {
+	page     int
+	pageSize int
+	listType string
+}
+
+func domainNames(domains []Domain) []string {
+	names := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		names = append(names, *domain.Name)
+	}
+	return names
+}
+
+func expectedDomainNames(from, to int) []string {
+	names := make([]string, 0, to-from)
+	for i := from; i < to; i++ {
+		names = append(names, fmt.Sprintf("domain%d.com", i))
+	}
+	return names
+}
+
+func TestDomainsGetListPager(t *testing.T) {
+	t.Run("next_page", func(t *testing.T) {
+		server, requests, _ := newDomainsGetListPagerServer(25, true, 0)
+		defer server.Close()
+
+		client := setupClient(nil, WithBaseURL(server.URL))
+		pager := client.Domains.NewGetListPager(&DomainsGetListArgs{ListType: String("EXPIRING"), PageSize: Int(10)})
+
+		var pages [][]string
+		for pager.More() {
+			domains, err := pager.NextPage(context.Background())
+			if err != nil {
+				t.Fatal("Unable to get page", err)
+			}
+			pages = append(pages, domainNames(domains))
+		}
+
+		assert.Equal(t, [][]string{expectedDomainNames(0, 10), expectedDomainNames(10, 20), expectedDomainNames(20, 25)}, pages)
+		assert.Equal(t, []domainsGetListPagerRequest{
+			{page: 1, pageSize: 10, listType: "EXPIRING"},
+			{page: 2, pageSize: 10, listType: "EXPIRING"},
+			{page: 3, pageSize: 10, listType: "EXPIRING"},
+		}, *requests)
+	})
+
+	t.Run("all_default_page_size", func(t *testing.T) {
+		server, requests, _ := newDomainsGetListPagerServer(250, true, 0)
+		defer server.Close()
+
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		domains, err := client.Domains.NewGetListPager(nil).All(context.Background())
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+
+		assert.Equal(t, expectedDomainNames(0, 250), domainNames(domains))
+		assert.Len(t, *requests, 3)
+		assert.Equal(t, 100, (*requests)[0].pageSize)
+	})
+
+	t.Run("all_with_prefetch", func(t *testing.T) {
+		server, requests, _ := newDomainsGetListPagerServer(95, true, 0)
+		defer server.Close()
+
+		client := setupClient(nil, WithBaseURL(server.URL))
+		pager := client.Domains.NewGetListPager(&DomainsGetListArgs{PageSize: Int(10)})
+		pager.Prefetch = 3
+
+		domains, err := pager.All(context.Background())
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+
+		assert.Equal(t, expectedDomainNames(0, 95), domainNames(domains))
+		assert.Len(t, *requests, 10)
+		assert.False(t, pager.More())
+	})
+
+	t.Run("without_paging_info", func(t *testing.T) {
+		server, requests, _ := newDomainsGetListPagerServer(20, false, 0)
+		defer server.Close()
+
+		client := setupClient(nil, WithBaseURL(server.URL))
+		pager := client.Domains.NewGetListPager(&DomainsGetListArgs{PageSize: Int(10)})
+		pager.Prefetch = 3
+
+		domains, err := pager.All(context.Background())
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+
+		assert.Equal(t, expectedDomainNames(0, 20), domainNames(domains))
+		assert.Len(t, *requests, 3)
+	})
+
+	t.Run("starting_page", func(t *testing.T) {
+		server, _, _ := newDomainsGetListPagerServer(30, true, 0)
+		defer server.Close()
+
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		domains, err := client.Domains.NewGetListPager(&DomainsGetListArgs{Page: Int(2), PageSize: Int(10)}).All(context.Background())
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+
+		assert.Equal(t, expectedDomainNames(10, 30), domainNames(domains))
+	})
+
+	t.Run("early_termination", func(t *testing.T) {
+		server, requests, mu := newDomainsGetListPagerServer(1000, true, 0)
+		defer server.Close()
+
+		client := setupClient(nil, WithBaseURL(server.URL))
+		pager := client.Domains.NewGetListPager(&DomainsGetListArgs{PageSize: Int(10)})
+		pager.Prefetch = 2
+
+		var names []string
+		pager.Domains(context.Background())(func(domain Domain, err error) bool {
+			assert.Nil(t, err)
+			names = append(names, *domain.Name)
+			return len(names) < 15
+		})
+
+		assert.Equal(t, expectedDomainNames(0, 15), names)
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.LessOrEqual(t, len(*requests), 4)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		server, _, _ := newDomainsGetListPagerServer(50, true, 3)
+		defer server.Close()
+
+		client := setupClient(nil, WithBaseURL(server.URL))
+
+		for _, prefetch := range []int{0, 2} {
+			pager := client.Domains.NewGetListPager(&DomainsGetListArgs{PageSize: Int(10)})
+			pager.Prefetch = prefetch
+
+			var names []string
+			var errs []error
+			pager.Domains(context.Background())(func(domain Domain, err error) bool {
+				if err != nil {
+					errs = append(errs, err)
+				} else {
+					names = append(names, *domain.Name)
+				}
+				return true
+			})
+
+			assert.Equal(t, expectedDomainNames(0, 20), names)
+			assert.Len(t, errs, 1)
+			assert.Contains(t, errs[0].Error(), "Page failed")
+
+			pager = client.Domains.NewGetListPager(&DomainsGetListArgs{PageSize: Int(10)})
+			pager.Prefetch = prefetch
+
+			domains, err := pager.All(context.Background())
+			assert.Nil(t, domains)
+			assert.Contains(t, err.Error(), "Page failed")
+		}
+	})
+
+	t.Run("invalid_args", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Domains.NewGetListPager(&DomainsGetListArgs{PageSize: Int(500)}).All(context.Background())
+		assert.EqualError(t, err, "invalid PageSize value: 500, minimum value is 10, and maximum value is 100")
+	})
+}
@@ -1,18 +1,11 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
-type GetRegistrarLockResponse struct {
-	XMLName         *xml.Name `xml:"ApiResponse"`
-	Errors          *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *GetRegistrarLockCommandResponse `xml:"CommandResponse"`
-}
+type GetRegistrarLockResponse = Response[GetRegistrarLockCommandResponse]
 
 type GetRegistrarLockCommandResponse struct {
 	Result *GetRegistrarLockResult `xml:"DomainGetRegistrarLockResult"`
@@ -31,22 +24,15 @@ func (r GetRegistrarLockResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains/get-registrar-lock/
 func (s *DomainsService) GetRegistrarLock(domain string) (*GetRegistrarLockCommandResponse, error) {
-	var response GetRegistrarLockResponse
+	return s.GetRegistrarLockContext(context.Background(), domain)
+}
 
+// GetRegistrarLockContext is like GetRegistrarLock but uses the provided context for the request and any retries
+func (s *DomainsService) GetRegistrarLockContext(ctx context.Context, domain string) (*GetRegistrarLockCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.getRegistrarLock",
 		"DomainName": domain,
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[GetRegistrarLockCommandResponse](ctx, s.client, params)
 }
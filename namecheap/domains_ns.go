@@ -6,5 +6,7 @@ package namecheap
 // DomainsNSService.GetInfo - gets info about a registered nameserver
 // DomainsNSService.Update - updates the IP address of a registered nameserver
 //
+// Every method has a ...Context variant taking a context.Context as its first argument.
+//
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-ns/
 type DomainsNSService service
@@ -1,18 +1,8 @@
 package namecheap
 
-import (
-	"encoding/xml"
-	"fmt"
-)
+import "context"
 
-type NameserversCreateResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *NameserversCreateCommandResponse `xml:"CommandResponse"`
-}
+type NameserversCreateResponse = Response[NameserversCreateCommandResponse]
 
 type NameserversCreateCommandResponse struct {
 	DomainNameserverInfoResult *DomainsNSCreateResult `xml:"DomainNSCreateResult"`
@@ -29,8 +19,11 @@ type DomainsNSCreateResult struct {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-ns/create/
 func (s *DomainsNSService) Create(sld, tld, nameserver, ipAddress string) (*NameserversCreateCommandResponse, error) {
-	var response NameserversCreateResponse
+	return s.CreateContext(context.Background(), sld, tld, nameserver, ipAddress)
+}
 
+// CreateContext is like Create but uses the provided context for the request and any retries
+func (s *DomainsNSService) CreateContext(ctx context.Context, sld, tld, nameserver, ipAddress string) (*NameserversCreateCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.ns.create",
 		"SLD":        sld,
@@ -39,15 +32,5 @@ func (s *DomainsNSService) Create(sld, tld, nameserver, ipAddress string) (*Name
 		"IP":         ipAddress,
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[NameserversCreateCommandResponse](ctx, s.client, params)
 }
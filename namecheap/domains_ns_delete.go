@@ -1,18 +1,8 @@
 package namecheap
 
-import (
-	"encoding/xml"
-	"fmt"
-)
+import "context"
 
-type NameserversDeleteResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *NameserversCreateCommandResponse `xml:"CommandResponse"`
-}
+type NameserversDeleteResponse = Response[NameserversDeleteCommandResponse]
 
 type NameserversDeleteCommandResponse struct {
 	DomainNameserverDeleteResult *DomainsNSDeleteResult `xml:"DomainNSDeleteResult"`
@@ -27,9 +17,12 @@ type DomainsNSDeleteResult struct {
 // Delete deletes a nameserver associated with the requested domain.
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-ns/delete/
-func (s *DomainsNSService) Delete(sld, tld, nameserver string) (*NameserversCreateCommandResponse, error) {
-	var response NameserversDeleteResponse
+func (s *DomainsNSService) Delete(sld, tld, nameserver string) (*NameserversDeleteCommandResponse, error) {
+	return s.DeleteContext(context.Background(), sld, tld, nameserver)
+}
 
+// DeleteContext is like Delete but uses the provided context for the request and any retries
+func (s *DomainsNSService) DeleteContext(ctx context.Context, sld, tld, nameserver string) (*NameserversDeleteCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.ns.delete",
 		"SLD":        sld,
@@ -37,15 +30,5 @@ func (s *DomainsNSService) Delete(sld, tld, nameserver string) (*NameserversCrea
 		"Nameserver": nameserver,
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[NameserversDeleteCommandResponse](ctx, s.client, params)
 }
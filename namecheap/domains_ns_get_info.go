@@ -1,18 +1,8 @@
 package namecheap
 
-import (
-	"encoding/xml"
-	"fmt"
-)
+import "context"
 
-type NameserversGetInfoResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *NameserversGetInfoCommandResponse `xml:"CommandResponse"`
-}
+type NameserversGetInfoResponse = Response[NameserversGetInfoCommandResponse]
 
 type NameserversGetInfoCommandResponse struct {
 	DomainNameserverInfoResult *DomainNSInfoResult `xml:"DomainNSInfoResult"`
@@ -31,8 +21,11 @@ type DomainNSInfoResult struct {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-ns/getinfo/
 func (s *DomainsNSService) GetInfo(sld, tld, nameserver string) (*NameserversGetInfoCommandResponse, error) {
-	var response NameserversGetInfoResponse
+	return s.GetInfoContext(context.Background(), sld, tld, nameserver)
+}
 
+// GetInfoContext is like GetInfo but uses the provided context for the request and any retries
+func (s *DomainsNSService) GetInfoContext(ctx context.Context, sld, tld, nameserver string) (*NameserversGetInfoCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.ns.getInfo",
 		"SLD":        sld,
@@ -40,15 +33,5 @@ func (s *DomainsNSService) GetInfo(sld, tld, nameserver string) (*NameserversGet
 		"Nameserver": nameserver,
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[NameserversGetInfoCommandResponse](ctx, s.client, params)
 }
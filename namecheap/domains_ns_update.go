@@ -1,18 +1,8 @@
 package namecheap
 
-import (
-	"encoding/xml"
-	"fmt"
-)
+import "context"
 
-type NameserversUpdateResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *NameserversCreateCommandResponse `xml:"CommandResponse"`
-}
+type NameserversUpdateResponse = Response[NameserversUpdateCommandResponse]
 
 type NameserversUpdateCommandResponse struct {
 	DomainNameserverUpdateResult *DomainsNSUpdateResult `xml:"DomainNSUpdateResult"`
@@ -27,9 +17,12 @@ type DomainsNSUpdateResult struct {
 // Update updates the IP address of a registered nameserver.
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains-ns/update/
-func (s *DomainsNSService) Update(sld, tld, nameserver, oldIP, ip string) (*NameserversCreateCommandResponse, error) {
-	var response NameserversUpdateResponse
+func (s *DomainsNSService) Update(sld, tld, nameserver, oldIP, ip string) (*NameserversUpdateCommandResponse, error) {
+	return s.UpdateContext(context.Background(), sld, tld, nameserver, oldIP, ip)
+}
 
+// UpdateContext is like Update but uses the provided context for the request and any retries
+func (s *DomainsNSService) UpdateContext(ctx context.Context, sld, tld, nameserver, oldIP, ip string) (*NameserversUpdateCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.ns.update",
 		"SLD":        sld,
@@ -39,15 +32,5 @@ func (s *DomainsNSService) Update(sld, tld, nameserver, oldIP, ip string) (*Name
 		"IP":         ip,
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[NameserversUpdateCommandResponse](ctx, s.client, params)
 }
@@ -1,7 +1,7 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 	"strconv"
 )
@@ -13,14 +13,7 @@ type ReactivateArgs struct {
 	PremiumPrice    *string
 }
 
-type ReactivateResponse struct {
-	XMLName         *xml.Name `xml:"ApiResponse"`
-	Errors          *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *ReactivateCommandResponse `xml:"CommandResponse"`
-}
+type ReactivateResponse = Response[ReactivateCommandResponse]
 
 type ReactivateCommandResponse struct {
 	DomainReactivateResult *ReactivateResult `xml:"DomainReactivateResult"`
@@ -90,8 +83,11 @@ func parseReactivateArgs(args *ReactivateArgs) (*map[string]string, error) {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains/reactivate/
 func (s *DomainsService) Reactivate(domain string, args *ReactivateArgs) (*ReactivateCommandResponse, error) {
-	var response ReactivateResponse
+	return s.ReactivateContext(context.Background(), domain, args)
+}
 
+// ReactivateContext is like Reactivate but uses the provided context for the request and any retries
+func (s *DomainsService) ReactivateContext(ctx context.Context, domain string, args *ReactivateArgs) (*ReactivateCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.reactivate",
 	}
@@ -108,15 +104,5 @@ func (s *DomainsService) Reactivate(domain string, args *ReactivateArgs) (*React
 		}
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[ReactivateCommandResponse](ctx, s.client, params)
 }
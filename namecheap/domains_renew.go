@@ -1,26 +1,19 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 	"strconv"
 )
 
 type RenewArgs struct {
-	Years            *int
-	PromotionCode    *string
-	IsPremiumDomain  *bool
-	PremiumPrice     *string
+	Years           *int
+	PromotionCode   *string
+	IsPremiumDomain *bool
+	PremiumPrice    *string
 }
 
-type RenewResponse struct {
-	XMLName         *xml.Name `xml:"ApiResponse"`
-	Errors          *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *RenewCommandResponse `xml:"CommandResponse"`
-}
+type RenewResponse = Response[RenewCommandResponse]
 
 type RenewCommandResponse struct {
 	DomainRenewResult *RenewResult `xml:"DomainRenewResult"`
@@ -90,8 +83,11 @@ func parseRenewArgs(args *RenewArgs) (*map[string]string, error) {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains/renew/
 func (s *DomainsService) Renew(domain string, args *RenewArgs) (*RenewCommandResponse, error) {
-	var response RenewResponse
+	return s.RenewContext(context.Background(), domain, args)
+}
 
+// RenewContext is like Renew but uses the provided context for the request and any retries
+func (s *DomainsService) RenewContext(ctx context.Context, domain string, args *RenewArgs) (*RenewCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.domains.renew",
 	}
@@ -106,15 +102,5 @@ func (s *DomainsService) Renew(domain string, args *RenewArgs) (*RenewCommandRes
 		params[k] = v
 	}
 
-	_, err = s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[RenewCommandResponse](ctx, s.client, params)
 }
@@ -0,0 +1,194 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type SetContactsArgs struct {
+	Registrant *ContactInfo
+	Tech       *ContactInfo
+	Admin      *ContactInfo
+	AuxBilling *ContactInfo
+	// AddressID is the ID of an address saved with UsersAddressService,
+	// it is used for the contacts which are not set
+	AddressID *int
+
+	// ExtendedAttributes are the TLD-specific attributes, e.g. RegistrantNexus for .us domains.
+	// They can't override the params set by the SDK, e.g. DomainName or RegistrantFirstName.
+	ExtendedAttributes map[string]string
+}
+
+// setContactsReservedParams are the params of namecheap.domains.setContacts set by the client and SetContacts
+var setContactsReservedParams = []string{"Command", "DomainName", "ApiUser", "ApiKey", "UserName", "ClientIp"}
+
+type DomainsSetContactsResponse = Response[DomainsSetContactsCommandResponse]
+
+type DomainsSetContactsCommandResponse struct {
+	DomainSetContactResult *DomainsSetContactsResult `xml:"DomainSetContactResult"`
+}
+
+type DomainsSetContactsResult struct {
+	Domain    *string `xml:"Domain,attr"`
+	IsSuccess *bool   `xml:"IsSuccess,attr"`
+}
+
+func (r DomainsSetContactsResult) String() string {
+	isSuccess := false
+	if r.IsSuccess != nil {
+		isSuccess = *r.IsSuccess
+	}
+	return fmt.Sprintf("{Domain: %s, IsSuccess: %t}", stringValue(r.Domain), isSuccess)
+}
+
+// ContactInfo converts the contact read by DomainsService.GetContacts to a ContactInfo
+// accepted by DomainsService.SetContacts and DomainsService.Create
+func (c DomainContactInfo) ContactInfo() *ContactInfo {
+	return &ContactInfo{
+		FirstName:           c.FirstName,
+		LastName:            c.LastName,
+		Address1:            c.Address1,
+		Address2:            c.Address2,
+		City:                c.City,
+		StateProvince:       c.StateProvince,
+		StateProvinceChoice: c.StateProvinceChoice,
+		PostalCode:          c.PostalCode,
+		Country:             c.Country,
+		Phone:               c.Phone,
+		PhoneExt:            c.PhoneExt,
+		Fax:                 c.Fax,
+		EmailAddress:        c.EmailAddress,
+		OrganizationName:    c.OrganizationName,
+		JobTitle:            c.JobTitle,
+	}
+}
+
+// SetContactsArgs returns the arguments of DomainsService.SetContacts setting the current contacts
+// and attributes of the domain, so that a contact can be modified and written back
+func (r DomainsGetContactsResult) SetContactsArgs() *SetContactsArgs {
+	args := &SetContactsArgs{}
+
+	if r.Registrant != nil {
+		args.Registrant = r.Registrant.ContactInfo()
+	}
+	if r.Tech != nil {
+		args.Tech = r.Tech.ContactInfo()
+	}
+	if r.Admin != nil {
+		args.Admin = r.Admin.ContactInfo()
+	}
+	if r.AuxBilling != nil {
+		args.AuxBilling = r.AuxBilling.ContactInfo()
+	}
+
+	if r.CurrentAttributes != nil {
+		attributes := map[string]string{}
+		if r.CurrentAttributes.RegistrantNexus != nil {
+			attributes["RegistrantNexus"] = *r.CurrentAttributes.RegistrantNexus
+		}
+		if r.CurrentAttributes.RegistrantNexusCountry != nil {
+			attributes["RegistrantNexusCountry"] = *r.CurrentAttributes.RegistrantNexusCountry
+		}
+		if r.CurrentAttributes.RegistrantPurpose != nil {
+			attributes["RegistrantPurpose"] = *r.CurrentAttributes.RegistrantPurpose
+		}
+		if len(attributes) > 0 {
+			args.ExtendedAttributes = attributes
+		}
+	}
+
+	return args
+}
+
+func validateSetContactsArgs(args *SetContactsArgs) error {
+	if args == nil {
+		return fmt.Errorf("SetContactsArgs is required")
+	}
+	if err := validateContactInfo(args.Registrant, "Registrant"); err != nil {
+		return err
+	}
+	if err := validateContactInfo(args.Tech, "Tech"); err != nil {
+		return err
+	}
+	if err := validateContactInfo(args.Admin, "Admin"); err != nil {
+		return err
+	}
+	if err := validateContactInfo(args.AuxBilling, "AuxBilling"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func parseSetContactsArgs(args *SetContactsArgs) (*map[string]string, error) {
+	params := map[string]string{}
+
+	err := validateSetContactsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	addContactToParams(params, args.Registrant, "Registrant")
+	addContactToParams(params, args.Tech, "Tech")
+	addContactToParams(params, args.Admin, "Admin")
+	addContactToParams(params, args.AuxBilling, "AuxBilling")
+
+	for k, v := range args.ExtendedAttributes {
+		if isSetContactsParam(params, k) {
+			return nil, fmt.Errorf("extended attribute %s conflicts with a param set by the SDK", k)
+		}
+		params[k] = v
+	}
+
+	return &params, nil
+}
+
+// isSetContactsParam reports whether the key is a reserved param or one of the params, ignoring the case
+func isSetContactsParam(params map[string]string, key string) bool {
+	for _, reserved := range setContactsReservedParams {
+		if strings.EqualFold(reserved, key) {
+			return true
+		}
+	}
+	for param := range params {
+		if strings.EqualFold(param, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetContacts sets contact information for the requested domain
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/domains/set-contacts/
+func (s *DomainsService) SetContacts(domain string, args *SetContactsArgs) (*DomainsSetContactsCommandResponse, error) {
+	return s.SetContactsContext(context.Background(), domain, args)
+}
+
+// SetContactsContext is like SetContacts but uses the provided context for the request and any retries
+func (s *DomainsService) SetContactsContext(ctx context.Context, domain string, args *SetContactsArgs) (*DomainsSetContactsCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.domains.setContacts",
+	}
+
+	if args != nil && args.AddressID != nil {
+		expanded := *args
+		err := (*UsersAddressService)(s).fillContacts(ctx, *args.AddressID, &expanded.Registrant, &expanded.Tech, &expanded.Admin, &expanded.AuxBilling)
+		if err != nil {
+			return nil, err
+		}
+		args = &expanded
+	}
+
+	parsedArgs, err := parseSetContactsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	params["DomainName"] = domain
+
+	return doCommand[DomainsSetContactsCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,161 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainsSetContacts(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+		  <Errors />
+		  <RequestedCommand>namecheap.domains.setContacts</RequestedCommand>
+		  <CommandResponse Type="namecheap.domains.setContacts">
+		    <DomainSetContactResult Domain="domain1.com" IsSuccess="true" />
+		  </CommandResponse>
+		  <Server>SERVER-NAME</Server>
+		  <GMTTimeDifference>+5</GMTTimeDifference>
+		  <ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	newContact := func(firstName string) *ContactInfo {
+		return &ContactInfo{
+			FirstName:        String(firstName),
+			LastName:         String("Smith"),
+			Address1:         String("8939 S. cross Blvd"),
+			City:             String("california"),
+			StateProvince:    String("ca"),
+			PostalCode:       String("90045"),
+			Country:          String("US"),
+			Phone:            String("+1.6613102107"),
+			EmailAddress:     String("john@gmail.com"),
+			OrganizationName: String("NameCheap.com"),
+		}
+	}
+
+	newArgs := func() *SetContactsArgs {
+		return &SetContactsArgs{
+			Registrant: newContact("John"),
+			Tech:       newContact("Jane"),
+			Admin:      newContact("Jack"),
+			AuxBilling: newContact("Jill"),
+		}
+	}
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		args := newArgs()
+		args.ExtendedAttributes = map[string]string{"RegistrantNexus": "C11", "RegistrantPurpose": "P1"}
+
+		_, err := client.Domains.SetContacts("domain1.com", args)
+		if err != nil {
+			t.Fatal("Unable to set contacts", err)
+		}
+
+		assert.Equal(t, "namecheap.domains.setContacts", sentBody.Get("Command"))
+		assert.Equal(t, "domain1.com", sentBody.Get("DomainName"))
+		assert.Equal(t, "John", sentBody.Get("RegistrantFirstName"))
+		assert.Equal(t, "Jane", sentBody.Get("TechFirstName"))
+		assert.Equal(t, "Jack", sentBody.Get("AdminFirstName"))
+		assert.Equal(t, "Jill", sentBody.Get("AuxBillingFirstName"))
+		assert.Equal(t, "NameCheap.com", sentBody.Get("RegistrantOrganizationName"))
+		assert.Equal(t, "C11", sentBody.Get("RegistrantNexus"))
+		assert.Equal(t, "P1", sentBody.Get("RegistrantPurpose"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Domains.SetContacts("domain1.com", newArgs())
+		if err != nil {
+			t.Fatal("Unable to set contacts", err)
+		}
+
+		assert.Equal(t, "domain1.com", *response.DomainSetContactResult.Domain)
+		assert.True(t, *response.DomainSetContactResult.IsSuccess)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Domains.SetContacts("domain1.com", nil)
+		assert.EqualError(t, err, "SetContactsArgs is required")
+
+		args := newArgs()
+		args.Admin = nil
+		_, err = client.Domains.SetContacts("domain1.com", args)
+		assert.EqualError(t, err, "Admin contact information is required")
+
+		args = newArgs()
+		args.Tech.EmailAddress = nil
+		_, err = client.Domains.SetContacts("domain1.com", args)
+		assert.EqualError(t, err, "TechEmailAddress is required")
+	})
+
+	t.Run("extended_attributes_conflicts", func(t *testing.T) {
+		client := setupClient(nil)
+
+		for _, key := range []string{"Command", "DomainName", "registrantfirstname", "ApiKey"} {
+			args := newArgs()
+			args.ExtendedAttributes = map[string]string{"RegistrantNexus": "C11", key: "value"}
+
+			_, err := client.Domains.SetContacts("domain1.com", args)
+			assert.EqualError(t, err, "extended attribute "+key+" conflicts with a param set by the SDK")
+		}
+	})
+
+	t.Run("read_modify_write", func(t *testing.T) {
+		result := DomainsGetContactsResult{
+			Domain: String("domain1.com"),
+			Registrant: &DomainContactInfo{
+				ReadOnly:     String("false"),
+				FirstName:    String("John"),
+				LastName:     String("Smith"),
+				PhoneExt:     String("123"),
+				EmailAddress: String("john@gmail.com"),
+			},
+			Tech: &DomainContactInfo{FirstName: String("Jane")},
+			CurrentAttributes: &CurrentAttributes{
+				RegistrantNexus: String("C11"),
+			},
+		}
+
+		args := result.SetContactsArgs()
+
+		assert.Equal(t, &ContactInfo{
+			FirstName:    String("John"),
+			LastName:     String("Smith"),
+			PhoneExt:     String("123"),
+			EmailAddress: String("john@gmail.com"),
+		}, args.Registrant)
+		assert.Equal(t, "Jane", *args.Tech.FirstName)
+		assert.Nil(t, args.Admin)
+		assert.Nil(t, args.AuxBilling)
+		assert.Equal(t, map[string]string{"RegistrantNexus": "C11"}, args.ExtendedAttributes)
+	})
+}
@@ -1,7 +1,7 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
@@ -12,14 +12,7 @@ const (
 	LockActionUnlock LockAction = "UNLOCK"
 )
 
-type SetRegistrarLockResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *SetRegistrarLockCommandResponse `xml:"CommandResponse"`
-}
+type SetRegistrarLockResponse = Response[SetRegistrarLockCommandResponse]
 
 type SetRegistrarLockCommandResponse struct {
 	Result *SetRegistrarLockResult `xml:"DomainSetRegistrarLockResult"`
@@ -38,8 +31,11 @@ func (r SetRegistrarLockResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/domains/set-registrar-lock/
 func (s *DomainsService) SetRegistrarLock(domain string, lockAction *LockAction) (*SetRegistrarLockCommandResponse, error) {
-	var response SetRegistrarLockResponse
+	return s.SetRegistrarLockContext(context.Background(), domain, lockAction)
+}
 
+// SetRegistrarLockContext is like SetRegistrarLock but uses the provided context for the request and any retries
+func (s *DomainsService) SetRegistrarLockContext(ctx context.Context, domain string, lockAction *LockAction) (*SetRegistrarLockCommandResponse, error) {
 	params := map[string]string{
 		"Command":    "namecheap.domains.setRegistrarLock",
 		"DomainName": domain,
@@ -49,15 +45,5 @@ func (s *DomainsService) SetRegistrarLock(domain string, lockAction *LockAction)
 		params["LockAction"] = string(*lockAction)
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[SetRegistrarLockCommandResponse](ctx, s.client, params)
 }
@@ -0,0 +1,12 @@
+package namecheap
+
+// DomainsTransferService includes the following methods:
+// DomainsTransferService.Create - transfers a domain to Namecheap
+// DomainsTransferService.GetStatus - gets the status of a particular transfer
+// DomainsTransferService.UpdateStatus - resubmits a transfer after the issue was fixed
+// DomainsTransferService.GetList - gets the list of domain transfers
+//
+// Every method has a ...Context variant taking a context.Context as its first argument.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/domains-transfer/
+type DomainsTransferService service
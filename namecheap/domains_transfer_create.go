@@ -0,0 +1,131 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type DomainsTransferCreateArgs struct {
+	DomainName *string
+	// Years is the number of years to renew after a successful transfer. Default value: 1
+	Years *int
+	// EPPCode is the authorization code of the domain at the losing registrar
+	EPPCode       *string
+	PromotionCode *string
+
+	AddFreeWhoisguard *bool
+	WGEnabled         *bool
+}
+
+type DomainsTransferCreateResponse = Response[DomainsTransferCreateCommandResponse]
+
+type DomainsTransferCreateCommandResponse struct {
+	DomainTransferCreateResult *DomainsTransferCreateResult `xml:"DomainTransferCreateResult"`
+}
+
+type DomainsTransferCreateResult struct {
+	DomainName    *string `xml:"DomainName,attr"`
+	Transfer      *bool   `xml:"Transfer,attr"`
+	TransferID    *int    `xml:"TransferID,attr"`
+	StatusID      *int    `xml:"StatusID,attr"`
+	OrderID       *int    `xml:"OrderID,attr"`
+	TransactionID *int    `xml:"TransactionID,attr"`
+	ChargedAmount *string `xml:"ChargedAmount,attr"`
+	StatusCode    *string `xml:"StatusCode,attr"`
+}
+
+func (r DomainsTransferCreateResult) String() string {
+	domainName := ""
+	if r.DomainName != nil {
+		domainName = *r.DomainName
+	}
+	transferID := 0
+	if r.TransferID != nil {
+		transferID = *r.TransferID
+	}
+	chargedAmount := ""
+	if r.ChargedAmount != nil {
+		chargedAmount = *r.ChargedAmount
+	}
+	return fmt.Sprintf("{DomainName: %s, TransferID: %d, ChargedAmount: %s}", domainName, transferID, chargedAmount)
+}
+
+func validateDomainsTransferCreateArgs(args *DomainsTransferCreateArgs) error {
+	if args == nil {
+		return fmt.Errorf("DomainsTransferCreateArgs is required")
+	}
+	if args.DomainName == nil || *args.DomainName == "" {
+		return fmt.Errorf("DomainName is required")
+	}
+	if args.EPPCode == nil || *args.EPPCode == "" {
+		return fmt.Errorf("EPPCode is required")
+	}
+	if args.Years != nil && (*args.Years < 1 || *args.Years > 10) {
+		return fmt.Errorf("Years must be between 1 and 10")
+	}
+	return nil
+}
+
+func parseDomainsTransferCreateArgs(args *DomainsTransferCreateArgs) (*map[string]string, error) {
+	err := validateDomainsTransferCreateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"DomainName": *args.DomainName,
+		"EPPCode":    *args.EPPCode,
+		"Years":      "1",
+	}
+
+	if args.Years != nil {
+		params["Years"] = strconv.Itoa(*args.Years)
+	}
+
+	if args.PromotionCode != nil {
+		params["PromotionCode"] = *args.PromotionCode
+	}
+
+	if args.AddFreeWhoisguard != nil {
+		if *args.AddFreeWhoisguard {
+			params["AddFreeWhoisguard"] = "yes"
+		} else {
+			params["AddFreeWhoisguard"] = "no"
+		}
+	}
+
+	if args.WGEnabled != nil {
+		if *args.WGEnabled {
+			params["WGenable"] = "yes"
+		} else {
+			params["WGenable"] = "no"
+		}
+	}
+
+	return &params, nil
+}
+
+// Create transfers a domain to Namecheap. The transfer is charged to the account.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/domains-transfer/create/
+func (s *DomainsTransferService) Create(args *DomainsTransferCreateArgs) (*DomainsTransferCreateCommandResponse, error) {
+	return s.CreateContext(context.Background(), args)
+}
+
+// CreateContext is like Create but uses the provided context for the request and any retries
+func (s *DomainsTransferService) CreateContext(ctx context.Context, args *DomainsTransferCreateArgs) (*DomainsTransferCreateCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.domains.transfer.create",
+	}
+
+	parsedArgs, err := parseDomainsTransferCreateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[DomainsTransferCreateCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,163 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainsTransferCreate(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.domains.transfer.create</RequestedCommand>
+			<CommandResponse Type="namecheap.domains.transfer.create">
+				<DomainTransferCreateResult DomainName="domain1.com" Transfer="true" TransferID="15" StatusID="-1" OrderID="1234" TransactionID="1234" ChargedAmount="10.0000" StatusCode="" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>12.915</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.DomainsTransfer.Create(&DomainsTransferCreateArgs{
+			DomainName: String("domain1.com"),
+			EPPCode:    String("epp-code"),
+		})
+		if err != nil {
+			t.Fatal("Unable to create transfer", err)
+		}
+
+		assert.Equal(t, "namecheap.domains.transfer.create", sentBody.Get("Command"))
+		assert.Equal(t, "domain1.com", sentBody.Get("DomainName"))
+		assert.Equal(t, "epp-code", sentBody.Get("EPPCode"))
+		assert.Equal(t, "1", sentBody.Get("Years"))
+	})
+
+	t.Run("request_with_all_args", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.DomainsTransfer.Create(&DomainsTransferCreateArgs{
+			DomainName:        String("domain1.com"),
+			EPPCode:           String("epp-code"),
+			Years:             Int(2),
+			PromotionCode:     String("PROMO123"),
+			AddFreeWhoisguard: Bool(true),
+			WGEnabled:         Bool(false),
+		})
+		if err != nil {
+			t.Fatal("Unable to create transfer", err)
+		}
+
+		assert.Equal(t, "2", sentBody.Get("Years"))
+		assert.Equal(t, "PROMO123", sentBody.Get("PromotionCode"))
+		assert.Equal(t, "yes", sentBody.Get("AddFreeWhoisguard"))
+		assert.Equal(t, "no", sentBody.Get("WGenable"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.DomainsTransfer.Create(&DomainsTransferCreateArgs{
+			DomainName: String("domain1.com"),
+			EPPCode:    String("epp-code"),
+		})
+		if err != nil {
+			t.Fatal("Unable to create transfer", err)
+		}
+
+		result := response.DomainTransferCreateResult
+		assert.Equal(t, "domain1.com", *result.DomainName)
+		assert.True(t, *result.Transfer)
+		assert.Equal(t, 15, *result.TransferID)
+		assert.Equal(t, -1, *result.StatusID)
+		assert.Equal(t, 1234, *result.OrderID)
+		assert.Equal(t, 1234, *result.TransactionID)
+		assert.Equal(t, "10.0000", *result.ChargedAmount)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.DomainsTransfer.Create(nil)
+		assert.EqualError(t, err, "DomainsTransferCreateArgs is required")
+
+		_, err = client.DomainsTransfer.Create(&DomainsTransferCreateArgs{EPPCode: String("epp-code")})
+		assert.EqualError(t, err, "DomainName is required")
+
+		_, err = client.DomainsTransfer.Create(&DomainsTransferCreateArgs{DomainName: String("domain1.com")})
+		assert.EqualError(t, err, "EPPCode is required")
+
+		_, err = client.DomainsTransfer.Create(&DomainsTransferCreateArgs{DomainName: String("domain1.com"), EPPCode: String("epp-code"), Years: Int(11)})
+		assert.EqualError(t, err, "Years must be between 1 and 10")
+	})
+
+	t.Run("server_respond_with_error", func(t *testing.T) {
+		fakeLocalResponse := `
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="ERROR" xmlns="http://api.namecheap.com/xml.response">
+				<Errors>
+					<Error Number="2033409">Order chargeable not found</Error>
+				</Errors>
+				<Warnings />
+				<RequestedCommand>namecheap.domains.transfer.create</RequestedCommand>
+				<Server>PHX01SBAPIEXT05</Server>
+				<GMTTimeDifference>--4:00</GMTTimeDifference>
+				<ExecutionTime>0.011</ExecutionTime>
+			</ApiResponse>
+		`
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeLocalResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.DomainsTransfer.Create(&DomainsTransferCreateArgs{
+			DomainName: String("domain1.com"),
+			EPPCode:    String("epp-code"),
+		})
+
+		assert.EqualError(t, err, "Order chargeable not found (2033409)")
+		assert.True(t, IsAPIErrorNumber(err, "2033409"))
+	})
+}
@@ -0,0 +1,154 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+var allowedTransferListTypeValues = []string{"ALL", "INPROGRESS", "CANCELLED", "COMPLETED"}
+var allowedTransferSortByValues = []string{"DOMAINNAME", "DOMAINNAME_DESC", "TRANSFERDATE", "TRANSFERDATE_DESC", "STATUSDATE", "STATUSDATE_DESC"}
+
+type DomainsTransferGetListResponse = Response[DomainsTransferGetListCommandResponse]
+
+type DomainsTransferGetListCommandResponse struct {
+	Transfers *[]Transfer           `xml:"TransferGetListResult>Transfer"`
+	Paging    *DomainsGetListPaging `xml:"Paging"`
+}
+
+// Transfer is a domain transfer of the list.
+// Its StatusID is the numeric status of the transfer, whose meaning is given by Status and StatusDescription.
+type Transfer struct {
+	ID                *int      `xml:"ID,attr"`
+	DomainName        *string   `xml:"DomainName,attr"`
+	User              *string   `xml:"User,attr"`
+	TransferDate      *DateTime `xml:"TransferDate,attr"`
+	OrderID           *int      `xml:"OrderID,attr"`
+	StatusID          *int      `xml:"StatusID,attr"`
+	Status            *string   `xml:"Status,attr"`
+	StatusDate        *DateTime `xml:"StatusDate,attr"`
+	StatusDescription *string   `xml:"StatusDescription,attr"`
+}
+
+func (t Transfer) String() string {
+	id := 0
+	if t.ID != nil {
+		id = *t.ID
+	}
+	domainName := ""
+	if t.DomainName != nil {
+		domainName = *t.DomainName
+	}
+	status := ""
+	if t.Status != nil {
+		status = *t.Status
+	}
+	return fmt.Sprintf("{ID: %d, DomainName: %s, Status: %s}", id, domainName, status)
+}
+
+// DomainsTransferGetListArgs struct is an input arguments for DomainsTransferService.GetList function
+type DomainsTransferGetListArgs struct {
+	// Possible values are ALL, INPROGRESS, CANCELLED, COMPLETED
+	// Default Value: ALL
+	ListType *string
+	// Keyword to look for in the transfer list
+	SearchTerm *string
+	// Page to return
+	// Default value: 1
+	Page *int
+	// Number of transfers to be listed on a page. Minimum value is 10, and maximum value is 100.
+	// Default value: 20
+	PageSize *int
+	// Possible values are DOMAINNAME, DOMAINNAME_DESC, TRANSFERDATE, TRANSFERDATE_DESC, STATUSDATE, STATUSDATE_DESC
+	SortBy *string
+}
+
+// GetList gets the list of domain transfers
+// DomainsTransferGetListArgs is the input arguments. When nil is passed, the API defaults are used.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/domains-transfer/get-list/
+func (s *DomainsTransferService) GetList(args *DomainsTransferGetListArgs) (*DomainsTransferGetListCommandResponse, error) {
+	return s.GetListContext(context.Background(), args)
+}
+
+// GetListContext is like GetList but uses the provided context for the request and any retries
+func (s *DomainsTransferService) GetListContext(ctx context.Context, args *DomainsTransferGetListArgs) (*DomainsTransferGetListCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.domains.transfer.getList",
+	}
+
+	parsedArgsMap, err := parseDomainsTransferGetListArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range *parsedArgsMap {
+		params[k] = v
+	}
+
+	return doCommand[DomainsTransferGetListCommandResponse](ctx, s.client, params)
+}
+
+func parseDomainsTransferGetListArgs(args *DomainsTransferGetListArgs) (*map[string]string, error) {
+	params := map[string]string{}
+
+	if args == nil {
+		return &params, nil
+	}
+
+	if args.ListType != nil {
+		if isValidTransferListType(*args.ListType) {
+			params["ListType"] = *args.ListType
+		} else {
+			return nil, fmt.Errorf("invalid ListType value: %s", *args.ListType)
+		}
+	}
+
+	if args.SortBy != nil {
+		if isValidTransferSortBy(*args.SortBy) {
+			params["SortBy"] = *args.SortBy
+		} else {
+			return nil, fmt.Errorf("invalid SortBy value: %s", *args.SortBy)
+		}
+	}
+
+	if args.Page != nil {
+		if *args.Page > 0 {
+			params["Page"] = strconv.Itoa(*args.Page)
+		} else {
+			return nil, fmt.Errorf("invalid Page value: %d, minimum value is 1", *args.Page)
+		}
+	}
+
+	if args.PageSize != nil {
+		if *args.PageSize >= 10 && *args.PageSize <= 100 {
+			params["PageSize"] = strconv.Itoa(*args.PageSize)
+		} else {
+			return nil, fmt.Errorf("invalid PageSize value: %d, minimum value is 10, and maximum value is 100", *args.PageSize)
+		}
+	}
+
+	if args.SearchTerm != nil {
+		params["SearchTerm"] = *args.SearchTerm
+	}
+
+	return &params, nil
+}
+
+func isValidTransferListType(listType string) bool {
+	for _, value := range allowedTransferListTypeValues {
+		if listType == value {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidTransferSortBy(sortBy string) bool {
+	for _, value := range allowedTransferSortByValues {
+		if sortBy == value {
+			return true
+		}
+	}
+	return false
+}
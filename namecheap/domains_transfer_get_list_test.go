@@ -0,0 +1,142 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainsTransferGetList(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.domains.transfer.getList</RequestedCommand>
+			<CommandResponse Type="namecheap.domains.transfer.getList">
+				<TransferGetListResult>
+					<Transfer ID="17" DomainName="domain1.com" User="owner" TransferDate="06/06/2021" OrderID="122" StatusID="5" Status="Completed" StatusDate="06/10/2021" StatusDescription="Domain transferred successfully" />
+					<Transfer ID="18" DomainName="domain2.com" User="owner" TransferDate="06/07/2021" OrderID="123" StatusID="-202" Status="Cancelled" StatusDate="06/08/2021" StatusDescription="Invalid EPP code" />
+				</TransferGetListResult>
+				<Paging>
+					<TotalItems>2</TotalItems>
+					<CurrentPage>1</CurrentPage>
+					<PageSize>20</PageSize>
+				</Paging>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.DomainsTransfer.GetList(nil)
+		if err != nil {
+			t.Fatal("Unable to get transfers", err)
+		}
+
+		assert.Equal(t, "namecheap.domains.transfer.getList", sentBody.Get("Command"))
+	})
+
+	t.Run("request_data_passing", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.DomainsTransfer.GetList(&DomainsTransferGetListArgs{
+			ListType:   String("INPROGRESS"),
+			SearchTerm: String("domain"),
+			Page:       Int(2),
+			PageSize:   Int(50),
+			SortBy:     String("TRANSFERDATE_DESC"),
+		})
+		if err != nil {
+			t.Fatal("Unable to get transfers", err)
+		}
+
+		assert.Equal(t, "INPROGRESS", sentBody.Get("ListType"))
+		assert.Equal(t, "domain", sentBody.Get("SearchTerm"))
+		assert.Equal(t, "2", sentBody.Get("Page"))
+		assert.Equal(t, "50", sentBody.Get("PageSize"))
+		assert.Equal(t, "TRANSFERDATE_DESC", sentBody.Get("SortBy"))
+	})
+
+	t.Run("request_data_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.DomainsTransfer.GetList(&DomainsTransferGetListArgs{ListType: String("EXPIRED")})
+		assert.EqualError(t, err, "invalid ListType value: EXPIRED")
+
+		_, err = client.DomainsTransfer.GetList(&DomainsTransferGetListArgs{SortBy: String("NAME")})
+		assert.EqualError(t, err, "invalid SortBy value: NAME")
+
+		_, err = client.DomainsTransfer.GetList(&DomainsTransferGetListArgs{Page: Int(0)})
+		assert.EqualError(t, err, "invalid Page value: 0, minimum value is 1")
+
+		_, err = client.DomainsTransfer.GetList(&DomainsTransferGetListArgs{PageSize: Int(101)})
+		assert.EqualError(t, err, "invalid PageSize value: 101, minimum value is 10, and maximum value is 100")
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.DomainsTransfer.GetList(nil)
+		if err != nil {
+			t.Fatal("Unable to get transfers", err)
+		}
+
+		transfers := *response.Transfers
+		assert.Len(t, transfers, 2)
+
+		assert.Equal(t, 17, *transfers[0].ID)
+		assert.Equal(t, "domain1.com", *transfers[0].DomainName)
+		assert.Equal(t, "owner", *transfers[0].User)
+		assert.Equal(t, time.Date(2021, 6, 6, 0, 0, 0, 0, time.UTC), transfers[0].TransferDate.Time)
+		assert.Equal(t, 122, *transfers[0].OrderID)
+		assert.Equal(t, 5, *transfers[0].StatusID)
+		assert.Equal(t, "Completed", *transfers[0].Status)
+		assert.Equal(t, time.Date(2021, 6, 10, 0, 0, 0, 0, time.UTC), transfers[0].StatusDate.Time)
+		assert.Equal(t, "Domain transferred successfully", *transfers[0].StatusDescription)
+
+		assert.Equal(t, -202, *transfers[1].StatusID)
+		assert.Equal(t, "Cancelled", *transfers[1].Status)
+
+		assert.Equal(t, 2, *response.Paging.TotalItems)
+		assert.Equal(t, 1, *response.Paging.CurrentPage)
+		assert.Equal(t, 20, *response.Paging.PageSize)
+	})
+}
@@ -0,0 +1,37 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type DomainsTransferGetStatusResponse = Response[DomainsTransferGetStatusCommandResponse]
+
+type DomainsTransferGetStatusCommandResponse struct {
+	DomainTransferGetStatusResult *DomainsTransferGetStatusResult `xml:"DomainTransferGetStatusResult"`
+}
+
+// DomainsTransferGetStatusResult is the status of a transfer,
+// StatusID is the numeric status whose meaning is given by Status
+type DomainsTransferGetStatusResult struct {
+	TransferID *int    `xml:"TransferID,attr"`
+	Status     *string `xml:"Status,attr"`
+	StatusID   *int    `xml:"StatusID,attr"`
+}
+
+// GetStatus gets the status of a particular transfer
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/domains-transfer/get-status/
+func (s *DomainsTransferService) GetStatus(transferID int) (*DomainsTransferGetStatusCommandResponse, error) {
+	return s.GetStatusContext(context.Background(), transferID)
+}
+
+// GetStatusContext is like GetStatus but uses the provided context for the request and any retries
+func (s *DomainsTransferService) GetStatusContext(ctx context.Context, transferID int) (*DomainsTransferGetStatusCommandResponse, error) {
+	params := map[string]string{
+		"Command":    "namecheap.domains.transfer.getStatus",
+		"TransferID": strconv.Itoa(transferID),
+	}
+
+	return doCommand[DomainsTransferGetStatusCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,99 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainsTransferGetStatus(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.domains.transfer.getStatus</RequestedCommand>
+			<CommandResponse Type="namecheap.domains.transfer.getStatus">
+				<DomainTransferGetStatusResult TransferID="15" Status="Completed" StatusID="5" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.DomainsTransfer.GetStatus(15)
+		if err != nil {
+			t.Fatal("Unable to get transfer status", err)
+		}
+
+		assert.Equal(t, "namecheap.domains.transfer.getStatus", sentBody.Get("Command"))
+		assert.Equal(t, "15", sentBody.Get("TransferID"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.DomainsTransfer.GetStatus(15)
+		if err != nil {
+			t.Fatal("Unable to get transfer status", err)
+		}
+
+		result := response.DomainTransferGetStatusResult
+		assert.Equal(t, 15, *result.TransferID)
+		assert.Equal(t, "Completed", *result.Status)
+		assert.Equal(t, 5, *result.StatusID)
+	})
+
+	t.Run("server_respond_with_error", func(t *testing.T) {
+		fakeLocalResponse := `
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="ERROR" xmlns="http://api.namecheap.com/xml.response">
+				<Errors>
+					<Error Number="2011150">Transfer not found</Error>
+				</Errors>
+				<Warnings />
+				<RequestedCommand>namecheap.domains.transfer.getstatus</RequestedCommand>
+				<Server>PHX01SBAPIEXT05</Server>
+				<GMTTimeDifference>--4:00</GMTTimeDifference>
+				<ExecutionTime>0.011</ExecutionTime>
+			</ApiResponse>
+		`
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeLocalResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.DomainsTransfer.GetStatus(15)
+
+		assert.EqualError(t, err, "Transfer not found (2011150)")
+	})
+}
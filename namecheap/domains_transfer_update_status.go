@@ -0,0 +1,35 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type DomainsTransferUpdateStatusResponse = Response[DomainsTransferUpdateStatusCommandResponse]
+
+type DomainsTransferUpdateStatusCommandResponse struct {
+	DomainTransferUpdateStatusResult *DomainsTransferUpdateStatusResult `xml:"DomainTransferUpdateStatusResult"`
+}
+
+type DomainsTransferUpdateStatusResult struct {
+	TransferID *int  `xml:"TransferID,attr"`
+	Resubmit   *bool `xml:"Resubmit,attr"`
+}
+
+// UpdateStatus resubmits a transfer after the issue which stopped it, e.g. a wrong EPP code, was fixed
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/domains-transfer/update-status/
+func (s *DomainsTransferService) UpdateStatus(transferID int, resubmit bool) (*DomainsTransferUpdateStatusCommandResponse, error) {
+	return s.UpdateStatusContext(context.Background(), transferID, resubmit)
+}
+
+// UpdateStatusContext is like UpdateStatus but uses the provided context for the request and any retries
+func (s *DomainsTransferService) UpdateStatusContext(ctx context.Context, transferID int, resubmit bool) (*DomainsTransferUpdateStatusCommandResponse, error) {
+	params := map[string]string{
+		"Command":    "namecheap.domains.transfer.updateStatus",
+		"TransferID": strconv.Itoa(transferID),
+		"Resubmit":   strconv.FormatBool(resubmit),
+	}
+
+	return doCommand[DomainsTransferUpdateStatusCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,70 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainsTransferUpdateStatus(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.domains.transfer.updateStatus</RequestedCommand>
+			<CommandResponse Type="namecheap.domains.transfer.updateStatus">
+				<DomainTransferUpdateStatusResult TransferID="4" Resubmit="true" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.DomainsTransfer.UpdateStatus(4, true)
+		if err != nil {
+			t.Fatal("Unable to update transfer status", err)
+		}
+
+		assert.Equal(t, "namecheap.domains.transfer.updateStatus", sentBody.Get("Command"))
+		assert.Equal(t, "4", sentBody.Get("TransferID"))
+		assert.Equal(t, "true", sentBody.Get("Resubmit"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.DomainsTransfer.UpdateStatus(4, true)
+		if err != nil {
+			t.Fatal("Unable to update transfer status", err)
+		}
+
+		assert.Equal(t, 4, *response.DomainTransferUpdateStatusResult.TransferID)
+		assert.True(t, *response.DomainTransferUpdateStatusResult.Resubmit)
+	})
+}
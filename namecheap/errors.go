@@ -0,0 +1,126 @@
+package namecheap
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Namecheap error numbers the SDK knows how to classify
+//
+// Namecheap doc: https://www.namecheap.com/support/api/error-codes/
+const (
+	ErrorNumberAPIUserMissing        = "1010101"
+	ErrorNumberAPIKeyMissing         = "1010102"
+	ErrorNumberClientIPMissing       = "1010105"
+	ErrorNumberAPIUserInvalid        = "1011101"
+	ErrorNumberAPIKeyInvalid         = "1011102"
+	ErrorNumberRequestIPInvalid      = "1011150"
+	ErrorNumberUserNameUnauthorized  = "1016103"
+	ErrorNumberAPIUserDisabled       = "1017101"
+	ErrorNumberUserNameDisabled      = "1017103"
+	ErrorNumberClientIPDisabled      = "1017105"
+	ErrorNumberRequestIPDisabled     = "1017150"
+	ErrorNumberTooManyLoginAttempts  = "1017411"
+	ErrorNumberUserNameNotAvailable  = "1019103"
+	ErrorNumberDomainNameNotFound    = "2011166"
+	ErrorNumberDomainNotAssociated   = "2016166"
+	ErrorNumberDomainNotFound        = "2019166"
+	ErrorNumberDomainNotFoundForEdit = "2030166"
+	ErrorNumberTooManyRequests       = "500000"
+)
+
+var authErrorNumbers = []string{
+	ErrorNumberAPIUserMissing,
+	ErrorNumberAPIKeyMissing,
+	ErrorNumberClientIPMissing,
+	ErrorNumberAPIUserInvalid,
+	ErrorNumberAPIKeyInvalid,
+	ErrorNumberRequestIPInvalid,
+	ErrorNumberUserNameUnauthorized,
+	ErrorNumberAPIUserDisabled,
+	ErrorNumberUserNameDisabled,
+	ErrorNumberClientIPDisabled,
+	ErrorNumberRequestIPDisabled,
+	ErrorNumberTooManyLoginAttempts,
+	ErrorNumberUserNameNotAvailable,
+}
+
+var domainNotFoundErrorNumbers = []string{
+	ErrorNumberDomainNameNotFound,
+	ErrorNumberDomainNotFound,
+	ErrorNumberDomainNotFoundForEdit,
+}
+
+var tooManyRequestsErrorNumbers = []string{
+	ErrorNumberTooManyRequests,
+}
+
+// ErrorDetail is a single entry of the Errors block of an API response
+type ErrorDetail struct {
+	Number  string `xml:"Number,attr"`
+	Message string `xml:",chardata"`
+}
+
+// APIError is returned when the Namecheap API responds with one or more errors.
+// Number and Message are taken from the first reported error, Errors holds all of them.
+type APIError struct {
+	Command string
+	Number  string
+	Message string
+	Errors  []ErrorDetail
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s (%s)", e.Message, e.Number)
+}
+
+// HasNumber reports whether any of the reported errors has one of the numbers
+func (e *APIError) HasNumber(numbers ...string) bool {
+	for _, detail := range e.Errors {
+		for _, number := range numbers {
+			if detail.Number == number {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newAPIError(command string, details []ErrorDetail) *APIError {
+	return &APIError{
+		Command: command,
+		Number:  details[0].Number,
+		Message: details[0].Message,
+		Errors:  details,
+	}
+}
+
+// IsAPIErrorNumber reports whether err is an *APIError containing one of the error numbers
+func IsAPIErrorNumber(err error, numbers ...string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.HasNumber(numbers...)
+}
+
+// IsDomainNotFound reports whether err means the requested domain does not exist
+func IsDomainNotFound(err error) bool {
+	return IsAPIErrorNumber(err, domainNotFoundErrorNumbers...)
+}
+
+// IsDomainNotAssociated reports whether err means the domain belongs to another account
+func IsDomainNotAssociated(err error) bool {
+	return IsAPIErrorNumber(err, ErrorNumberDomainNotAssociated)
+}
+
+// IsAuthError reports whether err was caused by missing, invalid or locked API credentials
+// or a client IP address which is not whitelisted
+func IsAuthError(err error) bool {
+	return IsAPIErrorNumber(err, authErrorNumbers...)
+}
+
+// IsTooManyRequests reports whether err means the request was rejected because of the API rate limits
+func IsTooManyRequests(err error) bool {
+	return IsAPIErrorNumber(err, tooManyRequestsErrorNumbers...)
+}
@@ -0,0 +1,100 @@
+package namecheap
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	t.Run("error_message", func(t *testing.T) {
+		err := newAPIError("namecheap.domains.getInfo", []ErrorDetail{
+			{Number: "2019166", Message: "Domain not found"},
+		})
+
+		assert.EqualError(t, err, "Domain not found (2019166)")
+		assert.Equal(t, "namecheap.domains.getInfo", err.Command)
+		assert.Equal(t, "2019166", err.Number)
+		assert.Equal(t, "Domain not found", err.Message)
+	})
+
+	t.Run("has_number_checks_all_errors", func(t *testing.T) {
+		err := newAPIError("namecheap.domains.create", []ErrorDetail{
+			{Number: "2015182", Message: "Contact phone is invalid"},
+			{Number: "2033409", Message: "Order chargeable not found"},
+		})
+
+		assert.True(t, err.HasNumber("2033409"))
+		assert.True(t, err.HasNumber("1", "2015182"))
+		assert.False(t, err.HasNumber("2019166"))
+	})
+
+	t.Run("helpers_unwrap_errors", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", newAPIError("namecheap.domains.getInfo", []ErrorDetail{
+			{Number: ErrorNumberDomainNotFound, Message: "Domain not found"},
+		}))
+
+		assert.True(t, IsDomainNotFound(err))
+		assert.False(t, IsAuthError(err))
+		assert.False(t, IsDomainNotAssociated(err))
+	})
+
+	t.Run("helpers_on_non_api_error", func(t *testing.T) {
+		err := errors.New("Domain not found (2019166)")
+
+		assert.False(t, IsDomainNotFound(err))
+		assert.False(t, IsAPIErrorNumber(err, ErrorNumberDomainNotFound))
+		assert.False(t, IsDomainNotFound(nil))
+	})
+
+	t.Run("auth_error", func(t *testing.T) {
+		err := newAPIError("namecheap.users.getBalances", []ErrorDetail{
+			{Number: ErrorNumberRequestIPInvalid, Message: "Invalid request IP"},
+		})
+
+		assert.True(t, IsAuthError(err))
+	})
+
+	t.Run("returned_by_service_methods", func(t *testing.T) {
+		fakeResponse := `
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="ERROR" xmlns="http://api.namecheap.com/xml.response">
+				<Errors>
+					<Error Number="2016166">Domain is not associated with your account</Error>
+					<Error Number="3050900">Unknown error from Enom</Error>
+				</Errors>
+				<Warnings />
+				<RequestedCommand>namecheap.domains.getinfo</RequestedCommand>
+				<Server>PHX01SBAPIEXT05</Server>
+				<GMTTimeDifference>--4:00</GMTTimeDifference>
+				<ExecutionTime>0.011</ExecutionTime>
+			</ApiResponse>
+		`
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Domains.GetInfo("domain.com")
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatal("Expected APIError", err)
+		}
+
+		assert.Equal(t, "namecheap.domains.getInfo", apiErr.Command)
+		assert.Equal(t, []ErrorDetail{
+			{Number: "2016166", Message: "Domain is not associated with your account"},
+			{Number: "3050900", Message: "Unknown error from Enom"},
+		}, apiErr.Errors)
+		assert.True(t, IsDomainNotAssociated(err))
+	})
+}
@@ -0,0 +1,183 @@
+package namecheap
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// redacted replaces the secrets in the params passed to the request hooks
+const redacted = "REDACTED"
+
+// SecretParams are the request params holding secrets: the API key, the passwords, the password reset code
+// and the EPP code of transfers. They are redacted from the params passed to the request hooks.
+var SecretParams = []string{"ApiKey", "Password", "OldPassword", "NewPassword", "NewUserPassword", "ResetCode", "EPPCode"}
+
+// RequestInfo describes an attempt of a request, see WithRequestHooks
+type RequestInfo struct {
+	// Command is the Namecheap command of the request, e.g. namecheap.domains.dns.setHosts
+	Command string
+	// Params are the params sent with the request with the SecretParams redacted
+	Params map[string]string
+	// Attempt is the number of the attempt starting from 1
+	Attempt int
+}
+
+// ResponseInfo describes the outcome of an attempt of a request
+type ResponseInfo struct {
+	RequestInfo
+	// StatusCode is the HTTP status of the response or 0 if no response was received
+	StatusCode int
+	// Duration is the time the attempt took, excluding the wait for the rate limiter.
+	// For RequestHooks.Finish it is the time the whole request took, retries included.
+	Duration time.Duration
+	// ErrorNumbers are the numbers of the errors reported by the API
+	ErrorNumbers []string
+	// Err is the error of the attempt, an *APIError when the API responded with an error
+	Err error
+	// Metadata is the envelope of the response, nil when no response could be decoded
+	Metadata *ResponseMetadata
+}
+
+// RequestHooks are called around every request and every attempt of a request, retries included
+type RequestHooks struct {
+	// Start is called once before the first attempt of a request. The returned context is used
+	// for all the attempts and passed to the other hooks, e.g. to carry a tracing span.
+	// A nil context keeps the original one.
+	Start func(ctx context.Context, info RequestInfo) context.Context
+	// Before is called before the attempt is sent. The returned context is used for the attempt
+	// and passed to After. A nil context keeps the original one.
+	Before func(ctx context.Context, info RequestInfo) context.Context
+	// After is called once the attempt succeeded or failed
+	After func(ctx context.Context, info ResponseInfo)
+	// Finish is called once the request succeeded or failed for good with the outcome of its last attempt
+	Finish func(ctx context.Context, info ResponseInfo)
+}
+
+// startRequest calls the Start hooks and returns the context of the request
+func (c *Client) startRequest(ctx context.Context, info RequestInfo) context.Context {
+	for _, hooks := range c.hooks {
+		if hooks.Start == nil {
+			continue
+		}
+		if hookCtx := hooks.Start(ctx, info); hookCtx != nil {
+			ctx = hookCtx
+		}
+	}
+	return ctx
+}
+
+// finishRequest calls the Finish hooks in the reverse order of the Start hooks
+func (c *Client) finishRequest(ctx context.Context, info ResponseInfo) {
+	for i := len(c.hooks) - 1; i >= 0; i-- {
+		if c.hooks[i].Finish != nil {
+			c.hooks[i].Finish(ctx, info)
+		}
+	}
+}
+
+// beforeRequest calls the Before hooks and returns the context of the attempt
+func (c *Client) beforeRequest(ctx context.Context, info RequestInfo) context.Context {
+	for _, hooks := range c.hooks {
+		if hooks.Before == nil {
+			continue
+		}
+		if hookCtx := hooks.Before(ctx, info); hookCtx != nil {
+			ctx = hookCtx
+		}
+	}
+	return ctx
+}
+
+// afterRequest calls the After hooks in the reverse order of the Before hooks
+func (c *Client) afterRequest(ctx context.Context, info ResponseInfo) {
+	for i := len(c.hooks) - 1; i >= 0; i-- {
+		if c.hooks[i].After != nil {
+			c.hooks[i].After(ctx, info)
+		}
+	}
+}
+
+// requestInfo returns the description of the attempt passed to the hooks
+func (c *Client) requestInfo(body map[string]string, attempt int) RequestInfo {
+	params := make(map[string]string, len(body)+4)
+	for key, value := range body {
+		params[key] = value
+	}
+
+	params["Username"] = c.ClientOptions.UserName
+	params["ApiKey"] = c.ClientOptions.ApiKey
+	params["ApiUser"] = c.ClientOptions.ApiUser
+	params["ClientIp"] = c.ClientOptions.ClientIp
+
+	for key := range params {
+		if IsSecretParam(key) {
+			params[key] = redacted
+		}
+	}
+
+	return RequestInfo{Command: body["Command"], Params: params, Attempt: attempt}
+}
+
+// IsSecretParam reports whether the param is one of the SecretParams, the name is matched case-insensitively
+func IsSecretParam(key string) bool {
+	for _, param := range SecretParams {
+		if strings.EqualFold(param, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorNumbers returns the numbers of the errors reported by the API
+func errorNumbers(err error) []string {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return nil
+	}
+
+	numbers := make([]string, 0, len(apiErr.Errors))
+	for _, detail := range apiErr.Errors {
+		numbers = append(numbers, detail.Number)
+	}
+	if len(numbers) == 0 && apiErr.Number != "" {
+		numbers = append(numbers, apiErr.Number)
+	}
+
+	return numbers
+}
+
+// loggingHooks logs every attempt, successful ones at debug level and failed ones at warn level
+func loggingHooks(logger *slog.Logger) RequestHooks {
+	return RequestHooks{
+		After: func(ctx context.Context, info ResponseInfo) {
+			level := slog.LevelDebug
+			if info.Err != nil {
+				level = slog.LevelWarn
+			}
+			if !logger.Enabled(ctx, level) {
+				return
+			}
+
+			params := make([]any, 0, len(info.Params))
+			for key, value := range info.Params {
+				params = append(params, slog.String(key, value))
+			}
+
+			attrs := []any{
+				slog.String("command", info.Command),
+				slog.Int("attempt", info.Attempt),
+				slog.Int("status", info.StatusCode),
+				slog.Duration("duration", info.Duration),
+				slog.Group("params", params...),
+			}
+			if info.Err != nil {
+				attrs = append(attrs, slog.Any("error_numbers", info.ErrorNumbers), slog.String("error", info.Err.Error()))
+			}
+
+			logger.Log(ctx, level, "namecheap request", attrs...)
+		},
+	}
+}
@@ -0,0 +1,266 @@
+package namecheap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestHooks(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="utf-8"?>
+		<ApiResponse Status="ERROR" xmlns="http://api.namecheap.com/xml.response">
+			<Errors>
+				<Error Number="2019166">Domain not found</Error>
+			</Errors>
+			<Warnings />
+			<RequestedCommand>namecheap.domains.getinfo</RequestedCommand>
+			<Server>PHX01SBAPIEXT05</Server>
+			<GMTTimeDifference>--4:00</GMTTimeDifference>
+			<ExecutionTime>0.024</ExecutionTime>
+		</ApiResponse>
+	`
+
+	newMockServer := func() *httptest.Server {
+		requests := 0
+		return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			requests++
+			if requests == 1 {
+				writer.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+	}
+
+	type contextKey struct{}
+
+	t.Run("before_and_after", func(t *testing.T) {
+		mockServer := newMockServer()
+		defer mockServer.Close()
+
+		var calls []string
+		var before []RequestInfo
+		var after []ResponseInfo
+
+		client := setupClient(nil,
+			WithBaseURL(mockServer.URL),
+			WithRetryPolicy(&FixedDelays{Delays: []time.Duration{time.Millisecond}}),
+			WithRequestHooks(RequestHooks{
+				Before: func(ctx context.Context, info RequestInfo) context.Context {
+					calls = append(calls, "outer before")
+					before = append(before, info)
+					return context.WithValue(ctx, contextKey{}, info.Attempt)
+				},
+				After: func(ctx context.Context, info ResponseInfo) {
+					calls = append(calls, "outer after")
+					assert.Equal(t, info.Attempt, ctx.Value(contextKey{}))
+					after = append(after, info)
+				},
+			}),
+			WithRequestHooks(RequestHooks{
+				Before: func(ctx context.Context, info RequestInfo) context.Context {
+					calls = append(calls, "inner before")
+					return nil
+				},
+				After: func(ctx context.Context, info ResponseInfo) {
+					calls = append(calls, "inner after")
+				},
+			}),
+		)
+
+		_, err := client.Domains.GetInfo("domain.com")
+		assert.True(t, IsDomainNotFound(err))
+
+		assert.Equal(t, []string{
+			"outer before", "inner before", "inner after", "outer after",
+			"outer before", "inner before", "inner after", "outer after",
+		}, calls)
+
+		assert.Len(t, before, 2)
+		assert.Equal(t, "namecheap.domains.getInfo", before[0].Command)
+		assert.Equal(t, redacted, before[0].Params["ApiKey"])
+		assert.Equal(t, ncAPIUser, before[0].Params["ApiUser"])
+		assert.Equal(t, "domain.com", before[0].Params["DomainName"])
+
+		assert.Len(t, after, 2)
+		assert.Equal(t, 1, after[0].Attempt)
+		assert.Equal(t, http.StatusMethodNotAllowed, after[0].StatusCode)
+		assert.Nil(t, after[0].ErrorNumbers)
+		assert.NotNil(t, after[0].Err)
+
+		assert.Equal(t, 2, after[1].Attempt)
+		assert.Equal(t, http.StatusOK, after[1].StatusCode)
+		assert.Equal(t, []string{ErrorNumberDomainNotFound}, after[1].ErrorNumbers)
+		assert.True(t, IsDomainNotFound(after[1].Err))
+		assert.True(t, after[1].Duration > 0)
+
+		assert.Equal(t, ncAPIKey, client.ClientOptions.ApiKey)
+	})
+
+	t.Run("start_and_finish", func(t *testing.T) {
+		mockServer := newMockServer()
+		defer mockServer.Close()
+
+		var calls []string
+		var finished ResponseInfo
+
+		client := setupClient(nil,
+			WithBaseURL(mockServer.URL),
+			WithRetryPolicy(&FixedDelays{Delays: []time.Duration{time.Millisecond}}),
+			WithRequestHooks(RequestHooks{
+				Start: func(ctx context.Context, info RequestInfo) context.Context {
+					calls = append(calls, "start")
+					assert.Equal(t, 1, info.Attempt)
+					return context.WithValue(ctx, contextKey{}, "request")
+				},
+				Before: func(ctx context.Context, info RequestInfo) context.Context {
+					calls = append(calls, "before")
+					assert.Equal(t, "request", ctx.Value(contextKey{}))
+					return nil
+				},
+				After: func(ctx context.Context, info ResponseInfo) {
+					calls = append(calls, "after")
+				},
+				Finish: func(ctx context.Context, info ResponseInfo) {
+					calls = append(calls, "finish")
+					assert.Equal(t, "request", ctx.Value(contextKey{}))
+					finished = info
+				},
+			}),
+		)
+
+		_, err := client.Domains.GetInfo("domain.com")
+		assert.True(t, IsDomainNotFound(err))
+
+		assert.Equal(t, []string{"start", "before", "after", "before", "after", "finish"}, calls)
+		assert.Equal(t, 2, finished.Attempt)
+		assert.Equal(t, []string{ErrorNumberDomainNotFound}, finished.ErrorNumbers)
+		assert.Equal(t, 0.024, finished.Metadata.ExecutionTime)
+		assert.Equal(t, "PHX01SBAPIEXT05", finished.Metadata.Server)
+	})
+
+	t.Run("finish_after_retry_limit", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}))
+		defer mockServer.Close()
+
+		var finished ResponseInfo
+
+		client := setupClient(nil,
+			WithBaseURL(mockServer.URL),
+			WithRetryPolicy(&FixedDelays{Delays: []time.Duration{time.Millisecond}}),
+			WithRequestHooks(RequestHooks{
+				Finish: func(ctx context.Context, info ResponseInfo) {
+					finished = info
+				},
+			}),
+		)
+
+		_, err := client.Domains.GetInfo("domain.com")
+		assert.Equal(t, ErrRetryLimitExceeded, err)
+
+		assert.Equal(t, 2, finished.Attempt)
+		assert.Equal(t, http.StatusMethodNotAllowed, finished.StatusCode)
+		assert.Equal(t, ErrRetryLimitExceeded, finished.Err)
+		assert.Nil(t, finished.Metadata)
+	})
+
+	t.Run("passwords_redacted", func(t *testing.T) {
+		info := setupClient(nil).requestInfo(map[string]string{
+			"Command":     "namecheap.users.changePassword",
+			"OldPassword": "old",
+			"NewPassword": "new",
+		}, 1)
+
+		assert.Equal(t, redacted, info.Params["OldPassword"])
+		assert.Equal(t, redacted, info.Params["NewPassword"])
+		assert.Equal(t, redacted, info.Params["ApiKey"])
+		assert.Equal(t, ncUserName, info.Params["Username"])
+	})
+
+	t.Run("secret_params_redacted", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		var before []RequestInfo
+		client := setupClient(nil,
+			WithBaseURL(mockServer.URL),
+			WithRetryPolicy(nil),
+			WithRequestHooks(RequestHooks{
+				Before: func(ctx context.Context, info RequestInfo) context.Context {
+					before = append(before, info)
+					return ctx
+				},
+			}),
+		)
+
+		_, _ = client.DomainsTransfer.Create(&DomainsTransferCreateArgs{
+			DomainName: String("domain.com"),
+			EPPCode:    String("epp-secret"),
+		})
+		_, _ = client.Users.ChangePassword(&ChangePasswordArgs{
+			ResetCode:   String("reset-secret"),
+			NewPassword: String("new-secret"),
+		})
+
+		if assert.Len(t, before, 2) {
+			assert.Equal(t, redacted, before[0].Params["EPPCode"])
+			assert.Equal(t, "domain.com", before[0].Params["DomainName"])
+			assert.Equal(t, redacted, before[1].Params["ResetCode"])
+			assert.Equal(t, redacted, before[1].Params["NewPassword"])
+		}
+	})
+
+	t.Run("is_secret_param", func(t *testing.T) {
+		for _, param := range []string{"ApiKey", "apikey", "Password", "NewUserPassword", "RESETCODE", "EPPCode"} {
+			assert.True(t, IsSecretParam(param), param)
+		}
+		for _, param := range []string{"UserName", "DomainName", "PasswordHint"} {
+			assert.False(t, IsSecretParam(param), param)
+		}
+	})
+
+	t.Run("with_logger", func(t *testing.T) {
+		mockServer := newMockServer()
+		defer mockServer.Close()
+
+		var buffer bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		client := setupClient(nil,
+			WithBaseURL(mockServer.URL),
+			WithRetryPolicy(&FixedDelays{Delays: []time.Duration{time.Millisecond}}),
+			WithLogger(logger),
+		)
+
+		_, _ = client.Domains.GetInfo("domain.com")
+
+		lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+		assert.Len(t, lines, 2)
+		assert.NotContains(t, buffer.String(), ncAPIKey)
+
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(lines[1]), &record); err != nil {
+			t.Fatal("Unable to decode log record", err)
+		}
+		assert.Equal(t, "WARN", record["level"])
+		assert.Equal(t, "namecheap request", record["msg"])
+		assert.Equal(t, "namecheap.domains.getInfo", record["command"])
+		assert.Equal(t, float64(2), record["attempt"])
+		assert.Equal(t, float64(200), record["status"])
+		assert.Equal(t, []interface{}{ErrorNumberDomainNotFound}, record["error_numbers"])
+		assert.Equal(t, redacted, record["params"].(map[string]interface{})["ApiKey"])
+	})
+}
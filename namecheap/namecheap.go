@@ -2,17 +2,19 @@ package namecheap
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/go-cleanhttp"
-	"github.com/namecheap/go-namecheap-sdk/v2/namecheap/internal/syncretry"
 	"github.com/weppos/publicsuffix-go/publicsuffix"
 )
 
@@ -29,30 +31,41 @@ type ClientOptions struct {
 	UseSandbox bool
 }
 
+var defaultRetryDelays = []time.Duration{1 * time.Second, 5 * time.Second, 15 * time.Second, 30 * time.Second, 50 * time.Second}
+
 type Client struct {
-	http   *http.Client
-	common service
-	sr     *syncretry.SyncRetry
+	http        *http.Client
+	common      service
+	retryPolicy RetryPolicy
+	rateLimiter *RateLimiter
+	hooks       []RequestHooks
+	userAgent   string
+	timeout     time.Duration
 
 	ClientOptions *ClientOptions
 	BaseURL       string
 
-	Domains    *DomainsService
-	DomainsNS  *DomainsNSService
-	DomainsDNS *DomainsDNSService
-	Users      *UsersService
+	Domains         *DomainsService
+	DomainsNS       *DomainsNSService
+	DomainsDNS      *DomainsDNSService
+	DomainsTransfer *DomainsTransferService
+	SSL             *SSLService
+	Users           *UsersService
+	UsersAddress    *UsersAddressService
+	Whoisguard      *WhoisguardService
 }
 
 type service struct {
 	client *Client
 }
 
-// NewClient returns a new Namecheap API Client
-func NewClient(options *ClientOptions) *Client {
+// NewClient returns a new Namecheap API Client.
+// The optional settings can be changed through the opts, e.g. WithHTTPClient or WithBaseURL.
+func NewClient(options *ClientOptions, opts ...Option) *Client {
 	client := &Client{
 		ClientOptions: options,
 		http:          cleanhttp.DefaultClient(),
-		sr:            syncretry.NewSyncRetry(&syncretry.Options{Delays: []int{1, 5, 15, 30, 50}}),
+		retryPolicy:   &FixedDelays{Delays: defaultRetryDelays},
 	}
 
 	if options.UseSandbox {
@@ -61,17 +74,30 @@ func NewClient(options *ClientOptions) *Client {
 		client.BaseURL = namecheapProductionAPIURL
 	}
 
+	for _, opt := range opts {
+		opt(client)
+	}
+
 	client.common.client = client
 	client.Domains = (*DomainsService)(&client.common)
 	client.DomainsDNS = (*DomainsDNSService)(&client.common)
 	client.DomainsNS = (*DomainsNSService)(&client.common)
+	client.DomainsTransfer = (*DomainsTransferService)(&client.common)
+	client.SSL = (*SSLService)(&client.common)
 	client.Users = (*UsersService)(&client.common)
+	client.UsersAddress = (*UsersAddressService)(&client.common)
+	client.Whoisguard = (*WhoisguardService)(&client.common)
 
 	return client
 }
 
 // NewRequest creates a new request with the params
 func (c *Client) NewRequest(body map[string]string) (*http.Request, error) {
+	return c.NewRequestContext(context.Background(), body)
+}
+
+// NewRequestContext creates a new request with the params bound to the provided context
+func (c *Client) NewRequestContext(ctx context.Context, body map[string]string) (*http.Request, error) {
 	u, err := url.Parse(c.BaseURL)
 
 	if err != nil {
@@ -86,7 +112,7 @@ func (c *Client) NewRequest(body map[string]string) (*http.Request, error) {
 	rBody := encodeBody(body)
 
 	// Build the request
-	req, err := http.NewRequest("POST", u.String(), bytes.NewBufferString(rBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", u.String(), bytes.NewBufferString(rBody))
 
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %s", err)
@@ -95,38 +121,139 @@ func (c *Client) NewRequest(body map[string]string) (*http.Request, error) {
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Add("Content-Length", strconv.Itoa(len(rBody)))
 
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
 	return req, nil
 }
 
+// DoXML sends the request and decodes the XML response into obj
 func (c *Client) DoXML(body map[string]string, obj interface{}) (*http.Response, error) {
-	var requestResponse *http.Response
-	err := c.sr.Do(func() error {
-		request, err := c.NewRequest(body)
-		if err != nil {
-			return err
+	return c.DoXMLContext(context.Background(), body, obj)
+}
+
+// DoXMLContext is like DoXML but the request and the waits between retries are
+// aborted as soon as the provided context is cancelled or its deadline passes
+func (c *Client) DoXMLContext(ctx context.Context, body map[string]string, obj interface{}) (*http.Response, error) {
+	start := time.Now()
+
+	last := ResponseInfo{RequestInfo: c.requestInfo(body, 1)}
+	ctx = c.startRequest(ctx, last.RequestInfo)
+
+	response, err := c.doAttempts(ctx, body, obj, start, &last)
+
+	if err != nil {
+		last.Err = err
+		last.ErrorNumbers = errorNumbers(err)
+	}
+	last.Duration = time.Since(start)
+	c.finishRequest(ctx, last)
+
+	return response, err
+}
+
+// doAttempts sends the request until it succeeds or the retry policy gives up,
+// last is set to the outcome of every attempt
+func (c *Client) doAttempts(ctx context.Context, body map[string]string, obj interface{}, start time.Time, last *ResponseInfo) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
 		}
 
-		response, err := c.http.Do(request)
-		if err != nil {
-			return err
+		info := c.requestInfo(body, attempt)
+		attemptCtx := c.beforeRequest(ctx, info)
+		attemptStart := time.Now()
+
+		response, err := c.doAttempt(attemptCtx, body, obj)
+
+		failure := RetryAttempt{
+			Command: body["Command"],
+			Attempt: attempt,
+			Elapsed: time.Since(start),
+			Err:     err,
+		}
+		if response != nil {
+			failure.StatusCode = response.StatusCode
+		}
+		if err == nil {
+			if withErrors, ok := obj.(responseWithErrors); ok && len(withErrors.errorDetails()) > 0 {
+				failure.Err = newAPIError(failure.Command, withErrors.errorDetails())
+			}
 		}
 
-		if response.StatusCode == 405 {
-			return syncretry.ErrRetry
+		*last = ResponseInfo{
+			RequestInfo:  info,
+			StatusCode:   failure.StatusCode,
+			Duration:     time.Since(attemptStart),
+			ErrorNumbers: errorNumbers(failure.Err),
+			Err:          failure.Err,
 		}
+		if withMetadata, ok := obj.(responseWithMetadata); ok && err == nil {
+			metadata := withMetadata.responseMetadata()
+			last.Metadata = &metadata
+		}
+		c.afterRequest(attemptCtx, *last)
+
+		if failure.Err == nil || c.retryPolicy == nil || ctx.Err() != nil {
+			return response, err
+		}
+
+		delay, retry := c.retryPolicy.NextDelay(failure)
+		if !retry {
+			if failure.StatusCode == http.StatusMethodNotAllowed {
+				return nil, ErrRetryLimitExceeded
+			}
+			return response, err
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		resetBody(obj)
+	}
+}
 
-		requestResponse = response
-		defer response.Body.Close()
+// doAttempt sends the request once and decodes the response into obj
+func (c *Client) doAttempt(ctx context.Context, body map[string]string, obj interface{}) (*http.Response, error) {
+	requestCtx := ctx
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
 
-		err = decodeBody(response.Body, obj)
-		return err
-	})
+	request, err := c.NewRequestContext(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.http.Do(request)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && requestCtx.Err() == nil {
+			return nil, fmt.Errorf("%w: %w", ErrAttemptTimeout, err)
+		}
+		return nil, err
+	}
+	defer response.Body.Close()
 
-	if err != nil && errors.Is(err, syncretry.ErrRetryAttempts) {
-		return nil, fmt.Errorf("API retry limit exceeded")
+	if response.StatusCode == http.StatusMethodNotAllowed || response.StatusCode >= 500 {
+		return response, fmt.Errorf("unexpected response status: %s", response.Status)
 	}
 
-	return requestResponse, err
+	return response, decodeBody(response.Body, obj)
+}
+
+// resetBody sets the value obj points to back to its zero value
+// so that the next attempt doesn't decode on top of the previous one
+func resetBody(obj interface{}) {
+	value := reflect.ValueOf(obj)
+	if value.Kind() == reflect.Pointer && !value.IsNil() {
+		value.Elem().Set(reflect.Zero(value.Elem().Type()))
+	}
 }
 
 // decodeBody decodes the interface from received XML
@@ -1,11 +1,14 @@
 package namecheap
 
 import (
+	"context"
 	"io"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -17,20 +20,18 @@ const (
 	ncClientIP = "10.10.10.10"
 )
 
-func setupClient(httpClient *http.Client) *Client {
-	client := NewClient(&ClientOptions{
+func setupClient(httpClient *http.Client, opts ...Option) *Client {
+	if httpClient != nil {
+		opts = append(opts, WithHTTPClient(httpClient))
+	}
+
+	return NewClient(&ClientOptions{
 		UserName:   ncUserName,
 		ApiUser:    ncAPIUser,
 		ApiKey:     ncAPIKey,
 		ClientIp:   ncClientIP,
 		UseSandbox: false,
-	})
-
-	if httpClient != nil {
-		client.http = httpClient
-	}
-
-	return client
+	}, opts...)
 }
 
 func TestNewClient(t *testing.T) {
@@ -104,6 +105,63 @@ func TestNewRequest(t *testing.T) {
 	})
 }
 
+func TestNewRequestContext(t *testing.T) {
+	client := setupClient(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	request, err := client.NewRequestContext(ctx, map[string]string{
+		"Command": "command",
+	})
+	if err != nil {
+		t.Fatal("Unable to create a request", err)
+	}
+
+	assert.Equal(t, ctx, request.Context())
+}
+
+func TestDoXMLContext(t *testing.T) {
+	t.Run("cancelled_before_request", func(t *testing.T) {
+		called := false
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			called = true
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := client.DoXMLContext(ctx, map[string]string{"Command": "command"}, &struct{}{})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.False(t, called)
+	})
+
+	t.Run("cancelled_while_waiting_for_retry", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.DoXMLContext(ctx, map[string]string{"Command": "command"}, &struct{}{})
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
 func TestEncodeBody(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -0,0 +1,77 @@
+package namecheap
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Option configures optional Client settings, see NewClient
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests. It allows to supply
+// proxies, custom TLS configurations or instrumented transports.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.http = httpClient
+	}
+}
+
+// WithBaseURL overrides the API endpoint selected by ClientOptions.UseSandbox,
+// e.g. to point the client to a local stand-in server
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.BaseURL = baseURL
+	}
+}
+
+// WithRetryPolicy sets the policy deciding which failed requests are retried and when,
+// e.g. FixedDelays or ExponentialBackoff. A nil policy disables retries.
+//
+// The default policy is a FixedDelays retrying after 1, 5, 15, 30 and 50 seconds the throttled requests,
+// and the network failures, attempt timeouts and 5xx responses of idempotent commands.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout limits the duration of every single attempt of a request. An attempt exceeding it
+// fails with ErrAttemptTimeout and is retried like a network error by the retry policy.
+// Contrary to http.Client.Timeout it doesn't modify the client passed to WithHTTPClient.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithRateLimiter makes the client wait for the limiter before sending every request,
+// including retries. The same limiter can be passed to several clients to share the quotas.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithRequestHooks adds hooks called around every request and every attempt of a request, e.g. to trace
+// or measure the API calls. It can be passed several times, the Start and Before hooks are called in the order
+// they were added and the After and Finish hooks in the reverse order.
+func WithRequestHooks(hooks RequestHooks) Option {
+	return func(c *Client) {
+		c.hooks = append(c.hooks, hooks)
+	}
+}
+
+// WithLogger logs every attempt of a request with its command, params, HTTP status, duration
+// and error numbers. Successful attempts are logged at debug level, failed ones at warn level.
+// The SecretParams are redacted.
+func WithLogger(logger *slog.Logger) Option {
+	return WithRequestHooks(loggingHooks(logger))
+}
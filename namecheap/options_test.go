@@ -0,0 +1,197 @@
+package namecheap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func TestClientOptions(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="utf-8"?>
+		<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.getbalances</RequestedCommand>
+			<CommandResponse Type="namecheap.users.getBalances">
+				<UserGetBalancesResult Currency="USD" AvailableBalance="4932.96" AccountBalance="4932.96" EarnedAmount="381.70" WithdrawableAmount="1243.36" FundsRequiredForAutoRenew="0.00" />
+			</CommandResponse>
+			<Server>PHX01SBAPIEXT05</Server>
+			<GMTTimeDifference>--4:00</GMTTimeDifference>
+			<ExecutionTime>0.024</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("with_base_url", func(t *testing.T) {
+		called := false
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			called = true
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL))
+
+		_, err := client.Users.GetBalances()
+		if err != nil {
+			t.Fatal("Unable to get balances", err)
+		}
+
+		assert.Equal(t, mockServer.URL, client.BaseURL)
+		assert.True(t, called)
+	})
+
+	t.Run("with_http_client", func(t *testing.T) {
+		var sentURL string
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		transport := roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+			sentURL = request.URL.String()
+			return http.DefaultTransport.RoundTrip(request)
+		})
+
+		client := setupClient(&http.Client{Transport: transport}, WithBaseURL(mockServer.URL))
+
+		_, err := client.Users.GetBalances()
+		if err != nil {
+			t.Fatal("Unable to get balances", err)
+		}
+
+		assert.Equal(t, mockServer.URL, sentURL)
+	})
+
+	t.Run("with_user_agent", func(t *testing.T) {
+		var sentUserAgent string
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			sentUserAgent = request.UserAgent()
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithUserAgent("my-app/1.0"))
+
+		_, err := client.Users.GetBalances()
+		if err != nil {
+			t.Fatal("Unable to get balances", err)
+		}
+
+		assert.Equal(t, "my-app/1.0", sentUserAgent)
+	})
+
+	t.Run("with_timeout", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			select {
+			case <-request.Context().Done():
+			case <-time.After(time.Second):
+			}
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithTimeout(50*time.Millisecond), WithRetryPolicy(nil))
+
+		_, err := client.Users.GetBalances()
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorIs(t, err, ErrAttemptTimeout)
+	})
+
+	t.Run("with_timeout_retried", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				select {
+				case <-request.Context().Done():
+				case <-time.After(time.Second):
+				}
+			}
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil,
+			WithBaseURL(mockServer.URL),
+			WithTimeout(100*time.Millisecond),
+			WithRetryPolicy(&FixedDelays{Delays: []time.Duration{10 * time.Millisecond}}),
+		)
+
+		_, err := client.Users.GetBalances()
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("with_retry_policy", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				writer.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(&FixedDelays{Delays: []time.Duration{10 * time.Millisecond, 10 * time.Millisecond}}))
+
+		_, err := client.Users.GetBalances()
+		if err != nil {
+			t.Fatal("Unable to get balances", err)
+		}
+
+		assert.Equal(t, int32(3), calls)
+	})
+
+	t.Run("with_retry_policy_without_delays", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(&FixedDelays{}))
+
+		_, err := client.Users.GetBalances()
+
+		assert.ErrorIs(t, err, ErrRetryLimitExceeded)
+		assert.Equal(t, int32(1), calls)
+	})
+
+	t.Run("with_nil_retry_policy", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(nil))
+
+		_, err := client.Users.GetBalances()
+
+		assert.EqualError(t, err, "unexpected response status: 503 Service Unavailable")
+		assert.Equal(t, int32(1), calls)
+	})
+}
@@ -0,0 +1,216 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Default Namecheap API quotas of a single API user
+const (
+	DefaultRequestsPerMinute = 50
+	DefaultRequestsPerHour   = 700
+	DefaultRequestsPerDay    = 8000
+)
+
+// RateLimit allows Requests requests per Interval
+type RateLimit struct {
+	Requests int
+	Interval time.Duration
+}
+
+// RateLimitBucket is the state of the token bucket of a single RateLimit.
+// Limiters sharing a store share the bucket of each Interval, whatever their other limits.
+type RateLimitBucket struct {
+	Interval time.Duration `json:"interval"`
+	Tokens   float64       `json:"tokens"`
+	Updated  time.Time     `json:"updated"`
+}
+
+// RateLimitStore keeps the token buckets of rate limiters. Sharing a store between
+// several limiters, e.g. in different processes, makes them share the same quotas.
+type RateLimitStore interface {
+	// Update loads the buckets stored under key, passes them to fn and stores the returned ones.
+	// The whole update must be atomic with respect to other updates of the key.
+	// A key which was never stored is passed to fn as nil.
+	Update(ctx context.Context, key string, fn func(buckets []RateLimitBucket) []RateLimitBucket) error
+}
+
+// RateLimiterOptions configures NewRateLimiter, a zero limit disables the corresponding window
+type RateLimiterOptions struct {
+	PerMinute int
+	PerHour   int
+	PerDay    int
+
+	// Store keeps the token buckets. Default value: a new in-memory store
+	Store RateLimitStore
+	// Key identifies the buckets in the store, use distinct keys for distinct API users.
+	// Default value: "namecheap"
+	Key string
+}
+
+// RateLimiter is a token bucket limiter enforcing per-minute, per-hour and per-day quotas.
+// A single RateLimiter can be shared by several Client instances, see WithRateLimiter.
+type RateLimiter struct {
+	limits []RateLimit
+	store  RateLimitStore
+	key    string
+	now    func() time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter
+func NewRateLimiter(options RateLimiterOptions) *RateLimiter {
+	limiter := &RateLimiter{
+		store: options.Store,
+		key:   options.Key,
+		now:   time.Now,
+	}
+
+	if limiter.store == nil {
+		limiter.store = NewMemoryRateLimitStore()
+	}
+	if limiter.key == "" {
+		limiter.key = "namecheap"
+	}
+
+	for _, limit := range []RateLimit{
+		{Requests: options.PerMinute, Interval: time.Minute},
+		{Requests: options.PerHour, Interval: time.Hour},
+		{Requests: options.PerDay, Interval: 24 * time.Hour},
+	} {
+		if limit.Requests > 0 {
+			limiter.limits = append(limiter.limits, limit)
+		}
+	}
+
+	return limiter
+}
+
+// NewDefaultRateLimiter returns a RateLimiter enforcing the default Namecheap API quotas
+func NewDefaultRateLimiter() *RateLimiter {
+	return NewRateLimiter(RateLimiterOptions{
+		PerMinute: DefaultRequestsPerMinute,
+		PerHour:   DefaultRequestsPerHour,
+		PerDay:    DefaultRequestsPerDay,
+	})
+}
+
+// Limits returns the enabled limits
+func (l *RateLimiter) Limits() []RateLimit {
+	return append([]RateLimit(nil), l.limits...)
+}
+
+// Wait blocks until all the limits allow one more request and takes a token from each of them.
+// It returns early with the context error when ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait, err := l.reserve(ctx)
+		if err != nil {
+			return err
+		}
+		if wait == 0 {
+			return nil
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token from each bucket if all of them have one,
+// otherwise it returns the time until they will
+func (l *RateLimiter) reserve(ctx context.Context) (time.Duration, error) {
+	var wait time.Duration
+
+	err := l.store.Update(ctx, l.key, func(buckets []RateLimitBucket) []RateLimitBucket {
+		now := l.now()
+		wait = 0
+
+		buckets = withLimitBuckets(buckets, l.limits, now)
+
+		for _, limit := range l.limits {
+			bucket := &buckets[bucketIndex(buckets, limit.Interval)]
+
+			rate := float64(limit.Requests) / float64(limit.Interval)
+			elapsed := now.Sub(bucket.Updated)
+			if elapsed > 0 {
+				bucket.Tokens = math.Min(float64(limit.Requests), bucket.Tokens+float64(elapsed)*rate)
+				bucket.Updated = now
+			}
+
+			if bucket.Tokens < 1 {
+				missing := time.Duration(math.Ceil((1 - bucket.Tokens) / rate))
+				if missing > wait {
+					wait = missing
+				}
+			}
+		}
+
+		if wait == 0 {
+			for _, limit := range l.limits {
+				buckets[bucketIndex(buckets, limit.Interval)].Tokens--
+			}
+		}
+
+		return buckets
+	})
+	if err != nil {
+		return 0, fmt.Errorf("rate limiter: %w", err)
+	}
+
+	return wait, nil
+}
+
+// withLimitBuckets returns the stored buckets with a full bucket added for every limit without one.
+// The buckets of the windows of other limiters are kept, the ones stored without an interval are dropped.
+func withLimitBuckets(stored []RateLimitBucket, limits []RateLimit, now time.Time) []RateLimitBucket {
+	buckets := make([]RateLimitBucket, 0, len(stored)+len(limits))
+	for _, bucket := range stored {
+		if bucket.Interval > 0 {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	for _, limit := range limits {
+		if bucketIndex(buckets, limit.Interval) < 0 {
+			buckets = append(buckets, RateLimitBucket{Interval: limit.Interval, Tokens: float64(limit.Requests), Updated: now})
+		}
+	}
+
+	return buckets
+}
+
+// bucketIndex returns the index of the bucket of the interval or -1
+func bucketIndex(buckets []RateLimitBucket, interval time.Duration) int {
+	for i, bucket := range buckets {
+		if bucket.Interval == interval {
+			return i
+		}
+	}
+	return -1
+}
+
+// MemoryRateLimitStore is a RateLimitStore keeping the buckets in memory,
+// it coordinates the limiters of a single process
+type MemoryRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string][]RateLimitBucket
+}
+
+// NewMemoryRateLimitStore returns a new empty MemoryRateLimitStore
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets: map[string][]RateLimitBucket{},
+	}
+}
+
+func (s *MemoryRateLimitStore) Update(_ context.Context, key string, fn func(buckets []RateLimitBucket) []RateLimitBucket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.buckets[key] = fn(s.buckets[key])
+	return nil
+}
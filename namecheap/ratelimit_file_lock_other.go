@@ -0,0 +1,29 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd || windows)
+
+package namecheap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// lockFile creates the file at path, waiting for other processes to remove it.
+// The file is left behind if the process exits without calling the returned function.
+func lockFile(ctx context.Context, path string) (func(), error) {
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			_ = file.Close()
+			return func() { _ = os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("unable to lock rate limit store: %w", err)
+		}
+
+		if err := sleep(ctx, fileRateLimitStoreLockPoll); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,36 @@
+//go:build windows
+
+package namecheap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"syscall"
+)
+
+// errorSharingViolation is returned by CreateFile when another process has the file open
+const errorSharingViolation syscall.Errno = 32
+
+// lockFile opens the file at path without sharing it, waiting for other processes to close it.
+// The file is closed by the system if the process exits without calling the returned function.
+func lockFile(ctx context.Context, path string) (func(), error) {
+	name, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to lock rate limit store: %w", err)
+	}
+
+	for {
+		handle, err := syscall.CreateFile(name, syscall.GENERIC_WRITE, 0, nil, syscall.OPEN_ALWAYS, syscall.FILE_ATTRIBUTE_NORMAL, 0)
+		if err == nil {
+			return func() { _ = syscall.CloseHandle(handle) }, nil
+		}
+		if !errors.Is(err, errorSharingViolation) {
+			return nil, fmt.Errorf("unable to lock rate limit store: %w", err)
+		}
+
+		if err := sleep(ctx, fileRateLimitStoreLockPoll); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package namecheap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// fileRateLimitStoreLockPoll is the interval between two attempts to take the lock of the store
+const fileRateLimitStoreLockPoll = 10 * time.Millisecond
+
+// FileRateLimitStore is a RateLimitStore keeping the buckets in a JSON file,
+// it coordinates the limiters of several processes running on the same host.
+//
+// Updates are serialized through a lock file created next to the store file. On unix and windows the lock
+// is held by the operating system and released when the process exits. On other platforms it is the existence
+// of the lock file, which must be removed manually when a process is killed while holding it.
+type FileRateLimitStore struct {
+	path string
+}
+
+// NewFileRateLimitStore returns a FileRateLimitStore keeping the buckets in the file at path
+func NewFileRateLimitStore(path string) *FileRateLimitStore {
+	return &FileRateLimitStore{path: path}
+}
+
+func (s *FileRateLimitStore) Update(ctx context.Context, key string, fn func(buckets []RateLimitBucket) []RateLimitBucket) error {
+	unlock, err := lockFile(ctx, s.path+".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	state := map[string][]RateLimitBucket{}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("unable to read rate limit store: %w", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &state); err != nil {
+			return fmt.Errorf("unable to parse rate limit store: %w", err)
+		}
+	}
+
+	state[key] = fn(state[key])
+
+	data, err = json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("unable to write rate limit store: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("unable to write rate limit store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("unable to write rate limit store: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("unable to write rate limit store: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package namecheap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("limits", func(t *testing.T) {
+		limiter := NewRateLimiter(RateLimiterOptions{PerMinute: 10, PerDay: 100})
+
+		assert.Equal(t, []RateLimit{
+			{Requests: 10, Interval: time.Minute},
+			{Requests: 100, Interval: 24 * time.Hour},
+		}, limiter.Limits())
+	})
+
+	t.Run("default_limits", func(t *testing.T) {
+		limiter := NewDefaultRateLimiter()
+
+		assert.Equal(t, []RateLimit{
+			{Requests: DefaultRequestsPerMinute, Interval: time.Minute},
+			{Requests: DefaultRequestsPerHour, Interval: time.Hour},
+			{Requests: DefaultRequestsPerDay, Interval: 24 * time.Hour},
+		}, limiter.Limits())
+	})
+
+	t.Run("burst_then_wait", func(t *testing.T) {
+		clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+		limiter := NewRateLimiter(RateLimiterOptions{PerMinute: 2})
+		limiter.now = clock.Now
+
+		for i := 0; i < 2; i++ {
+			wait, err := limiter.reserve(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, time.Duration(0), wait)
+		}
+
+		wait, err := limiter.reserve(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 30*time.Second, wait)
+
+		clock.now = clock.now.Add(30 * time.Second)
+		wait, err = limiter.reserve(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, time.Duration(0), wait)
+	})
+
+	t.Run("strictest_limit_wins", func(t *testing.T) {
+		clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+		limiter := NewRateLimiter(RateLimiterOptions{PerMinute: 10, PerHour: 1})
+		limiter.now = clock.Now
+
+		wait, _ := limiter.reserve(context.Background())
+		assert.Equal(t, time.Duration(0), wait)
+
+		wait, _ = limiter.reserve(context.Background())
+		assert.Equal(t, time.Hour, wait)
+	})
+
+	t.Run("shared_store", func(t *testing.T) {
+		clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+		store := NewMemoryRateLimitStore()
+
+		first := NewRateLimiter(RateLimiterOptions{PerMinute: 1, Store: store})
+		first.now = clock.Now
+		second := NewRateLimiter(RateLimiterOptions{PerMinute: 1, Store: store})
+		second.now = clock.Now
+
+		wait, _ := first.reserve(context.Background())
+		assert.Equal(t, time.Duration(0), wait)
+
+		wait, _ = second.reserve(context.Background())
+		assert.Equal(t, time.Minute, wait)
+	})
+
+	t.Run("shared_store_different_limits", func(t *testing.T) {
+		clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+		store := NewMemoryRateLimitStore()
+
+		first := NewRateLimiter(RateLimiterOptions{PerMinute: 2, Store: store})
+		first.now = clock.Now
+		second := NewRateLimiter(RateLimiterOptions{PerMinute: 2, PerHour: 10, Store: store})
+		second.now = clock.Now
+
+		wait, _ := first.reserve(context.Background())
+		assert.Equal(t, time.Duration(0), wait)
+		wait, _ = second.reserve(context.Background())
+		assert.Equal(t, time.Duration(0), wait)
+
+		// both limiters took a token from the shared per-minute bucket
+		wait, _ = first.reserve(context.Background())
+		assert.Equal(t, 30*time.Second, wait)
+		wait, _ = second.reserve(context.Background())
+		assert.Equal(t, 30*time.Second, wait)
+
+		var buckets []RateLimitBucket
+		_ = store.Update(context.Background(), "namecheap", func(stored []RateLimitBucket) []RateLimitBucket {
+			buckets = stored
+			return stored
+		})
+		assert.Equal(t, []RateLimitBucket{
+			{Interval: time.Minute, Tokens: 0, Updated: clock.now},
+			{Interval: time.Hour, Tokens: 9, Updated: clock.now},
+		}, buckets)
+	})
+
+	t.Run("wait_respects_context", func(t *testing.T) {
+		limiter := NewRateLimiter(RateLimiterOptions{PerDay: 1})
+		assert.Nil(t, limiter.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+	})
+}
+
+func TestFileRateLimitStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ratelimit.json")
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	first := NewRateLimiter(RateLimiterOptions{PerMinute: 2, Store: NewFileRateLimitStore(path)})
+	first.now = clock.Now
+	second := NewRateLimiter(RateLimiterOptions{PerMinute: 2, Store: NewFileRateLimitStore(path)})
+	second.now = clock.Now
+
+	wait, err := first.reserve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+
+	wait, err = second.reserve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+
+	wait, err = first.reserve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 30*time.Second, wait)
+
+	other := NewRateLimiter(RateLimiterOptions{PerMinute: 2, Store: NewFileRateLimitStore(path), Key: "other-user"})
+	other.now = clock.Now
+
+	wait, err = other.reserve(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+
+	t.Run("concurrent_updates", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ratelimit.json")
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				store := NewFileRateLimitStore(path)
+				for j := 0; j < 10; j++ {
+					err := store.Update(context.Background(), "counter", func(buckets []RateLimitBucket) []RateLimitBucket {
+						if buckets == nil {
+							buckets = []RateLimitBucket{{Interval: time.Minute}}
+						}
+						buckets[0].Tokens++
+						return buckets
+					})
+					assert.Nil(t, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		_ = NewFileRateLimitStore(path).Update(context.Background(), "counter", func(buckets []RateLimitBucket) []RateLimitBucket {
+			assert.Equal(t, 100.0, buckets[0].Tokens)
+			return buckets
+		})
+	})
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="utf-8"?>
+		<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.getbalances</RequestedCommand>
+			<CommandResponse Type="namecheap.users.getBalances">
+				<UserGetBalancesResult Currency="USD" AvailableBalance="4932.96" AccountBalance="4932.96" EarnedAmount="381.70" WithdrawableAmount="1243.36" FundsRequiredForAutoRenew="0.00" />
+			</CommandResponse>
+			<Server>PHX01SBAPIEXT05</Server>
+			<GMTTimeDifference>--4:00</GMTTimeDifference>
+			<ExecutionTime>0.024</ExecutionTime>
+		</ApiResponse>
+	`
+
+	calls := int32(0)
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = writer.Write([]byte(fakeResponse))
+	}))
+	defer mockServer.Close()
+
+	limiter := NewRateLimiter(RateLimiterOptions{PerDay: 1})
+	first := setupClient(nil, WithBaseURL(mockServer.URL), WithRateLimiter(limiter))
+	second := setupClient(nil, WithBaseURL(mockServer.URL), WithRateLimiter(limiter))
+
+	_, err := first.Users.GetBalances()
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = second.Users.GetBalancesContext(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), calls)
+}
@@ -0,0 +1,91 @@
+package namecheap
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+)
+
+// ResponseMetadata holds the envelope fields returned with every API response
+type ResponseMetadata struct {
+	Status            string          `xml:"Status,attr"`
+	Warnings          []WarningDetail `xml:"Warnings>Warning"`
+	RequestedCommand  string          `xml:"RequestedCommand"`
+	Server            string          `xml:"Server"`
+	GMTTimeDifference string          `xml:"GMTTimeDifference"`
+	ExecutionTime     float64         `xml:"ExecutionTime"`
+}
+
+// WarningDetail is a single entry of the Warnings block of an API response
+type WarningDetail struct {
+	Number  string `xml:"Number,attr"`
+	Message string `xml:",chardata"`
+}
+
+// Response is the envelope shared by all API responses, T is the command specific payload
+type Response[T any] struct {
+	XMLName xml.Name `xml:"ApiResponse"`
+	ResponseMetadata
+	Errors          []ErrorDetail `xml:"Errors>Error"`
+	CommandResponse *T            `xml:"CommandResponse"`
+}
+
+func (r *Response[T]) errorDetails() []ErrorDetail {
+	return r.Errors
+}
+
+func (r *Response[T]) responseMetadata() ResponseMetadata {
+	return r.ResponseMetadata
+}
+
+// responseWithErrors is implemented by the response envelopes,
+// it lets DoXMLContext detect the errors which can be retried
+type responseWithErrors interface {
+	errorDetails() []ErrorDetail
+}
+
+// responseWithMetadata is implemented by the response envelopes,
+// it lets DoXMLContext pass the envelope metadata to the request hooks
+type responseWithMetadata interface {
+	responseMetadata() ResponseMetadata
+}
+
+type responseMetadataKey struct{}
+
+// WithResponseMetadata returns a copy of ctx which makes the Context variants of the service methods
+// store the envelope metadata of the response into metadata. It is filled for failed commands too.
+//
+// A single metadata value must not be shared by concurrent requests.
+func WithResponseMetadata(ctx context.Context, metadata *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, metadata)
+}
+
+// doCommand sends the command described by params, decodes the response envelope and
+// converts the reported errors into *APIError
+func doCommand[T any](ctx context.Context, c *Client, params map[string]string) (*T, error) {
+	var response Response[T]
+
+	_, err := c.DoXMLContext(ctx, params, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata); ok && metadata != nil {
+		*metadata = response.ResponseMetadata
+	}
+
+	if len(response.Errors) > 0 {
+		return nil, newAPIError(params["Command"], response.Errors)
+	}
+
+	return response.CommandResponse, nil
+}
+
+// asAPIError returns the *APIError wrapped by err or nil
+func asAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	return nil
+}
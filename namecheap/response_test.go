@@ -0,0 +1,99 @@
+package namecheap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponse(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="utf-8"?>
+		<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+			<Errors />
+			<Warnings>
+				<Warning Number="1234">Some warning</Warning>
+			</Warnings>
+			<RequestedCommand>namecheap.users.getbalances</RequestedCommand>
+			<CommandResponse Type="namecheap.users.getBalances">
+				<UserGetBalancesResult Currency="USD" AvailableBalance="4932.96" AccountBalance="4932.96" EarnedAmount="381.70" WithdrawableAmount="1243.36" FundsRequiredForAutoRenew="0.00" />
+			</CommandResponse>
+			<Server>PHX01SBAPIEXT05</Server>
+			<GMTTimeDifference>--4:00</GMTTimeDifference>
+			<ExecutionTime>0.024</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("decode_envelope", func(t *testing.T) {
+		var response Response[GetBalancesCommandResponse]
+
+		err := decodeBody(strings.NewReader(fakeResponse), &response)
+		if err != nil {
+			t.Fatal("Unable to decode", err)
+		}
+
+		assert.Equal(t, "OK", response.Status)
+		assert.Equal(t, []WarningDetail{{Number: "1234", Message: "Some warning"}}, response.Warnings)
+		assert.Equal(t, "namecheap.users.getbalances", response.RequestedCommand)
+		assert.Equal(t, "PHX01SBAPIEXT05", response.Server)
+		assert.Equal(t, "--4:00", response.GMTTimeDifference)
+		assert.Equal(t, 0.024, response.ExecutionTime)
+		assert.Empty(t, response.Errors)
+		assert.Equal(t, "USD", *response.CommandResponse.UserGetBalancesResult.Currency)
+	})
+
+	t.Run("metadata_from_context", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		var metadata ResponseMetadata
+		_, err := client.Users.GetBalancesContext(WithResponseMetadata(context.Background(), &metadata))
+		if err != nil {
+			t.Fatal("Unable to get balances", err)
+		}
+
+		assert.Equal(t, "OK", metadata.Status)
+		assert.Equal(t, "PHX01SBAPIEXT05", metadata.Server)
+		assert.Equal(t, 0.024, metadata.ExecutionTime)
+	})
+
+	t.Run("metadata_from_context_on_error", func(t *testing.T) {
+		fakeLocalResponse := `
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="ERROR" xmlns="http://api.namecheap.com/xml.response">
+				<Errors>
+					<Error Number="1011150">Invalid API key</Error>
+				</Errors>
+				<Warnings />
+				<RequestedCommand>namecheap.users.getbalances</RequestedCommand>
+				<Server>PHX01SBAPIEXT06</Server>
+				<GMTTimeDifference>--4:00</GMTTimeDifference>
+				<ExecutionTime>0.011</ExecutionTime>
+			</ApiResponse>
+		`
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeLocalResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		var metadata ResponseMetadata
+		_, err := client.Users.GetBalancesContext(WithResponseMetadata(context.Background(), &metadata))
+
+		assert.True(t, IsAuthError(err))
+		assert.Equal(t, "ERROR", metadata.Status)
+		assert.Equal(t, "PHX01SBAPIEXT06", metadata.Server)
+	})
+}
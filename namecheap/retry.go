@@ -0,0 +1,185 @@
+package namecheap
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrRetryLimitExceeded is returned when a request is still throttled after all the retries
+var ErrRetryLimitExceeded = errors.New("API retry limit exceeded")
+
+// ErrAttemptTimeout is wrapped in the error of an attempt cut short by WithTimeout while the context
+// of the request is still alive. Such an attempt is a network error and may be retried.
+var ErrAttemptTimeout = errors.New("attempt timeout exceeded")
+
+// nonIdempotentCommands are the commands which charge the account, create orders, accounts or addresses
+// or reissue certificates. Repeating them after a failure which happened after the request was sent
+// may result in a double purchase or a duplicate.
+var nonIdempotentCommands = map[string]bool{
+	"namecheap.domains.create":              true,
+	"namecheap.domains.renew":               true,
+	"namecheap.domains.reactivate":          true,
+	"namecheap.domains.transfer.create":     true,
+	"namecheap.ssl.create":                  true,
+	"namecheap.ssl.renew":                   true,
+	"namecheap.ssl.reissue":                 true,
+	"namecheap.users.create":                true,
+	"namecheap.users.createaddfundsrequest": true,
+	"namecheap.users.address.create":        true,
+	"namecheap.whoisguard.renew":            true,
+}
+
+// IsIdempotentCommand reports whether the command can be repeated safely after a network
+// or server failure. Commands which charge the account, e.g. namecheap.domains.create, are not.
+func IsIdempotentCommand(command string) bool {
+	return !nonIdempotentCommands[strings.ToLower(command)]
+}
+
+// RetryAttempt describes a failed attempt of a request
+type RetryAttempt struct {
+	// Command is the Namecheap command of the request, e.g. namecheap.domains.getList
+	Command string
+	// Attempt is the number of the failed attempt starting from 1
+	Attempt int
+	// Elapsed is the time passed since the first attempt was sent
+	Elapsed time.Duration
+	// StatusCode is the HTTP status of the response or 0 if no response was received
+	StatusCode int
+	// Err is the error of the attempt, an *APIError when the API responded with an error
+	Err error
+}
+
+// Throttled reports whether the API rejected the request because of its rate limits.
+// Throttled requests were not processed, so they can be retried regardless of the command.
+func (a RetryAttempt) Throttled() bool {
+	return a.StatusCode == http.StatusMethodNotAllowed || IsTooManyRequests(a.Err)
+}
+
+// NetworkError reports whether the attempt failed before a response was received,
+// including when it exceeded the WithTimeout limit but not when the context of the request is done
+func (a RetryAttempt) NetworkError() bool {
+	if a.Err == nil || a.StatusCode != 0 {
+		return false
+	}
+	if errors.Is(a.Err, ErrAttemptTimeout) {
+		return true
+	}
+	return !errors.Is(a.Err, context.Canceled) && !errors.Is(a.Err, context.DeadlineExceeded)
+}
+
+// ServerError reports whether the API responded with a 5xx status
+func (a RetryAttempt) ServerError() bool {
+	return a.StatusCode >= 500
+}
+
+// Retryable reports whether the attempt failed with a throttling, network or server error
+// and repeating it is safe. Non-idempotent commands are only retried when throttled,
+// unless retryNonIdempotent is set.
+func (a RetryAttempt) Retryable(retryNonIdempotent bool) bool {
+	if a.Throttled() {
+		return true
+	}
+	if !retryNonIdempotent && !IsIdempotentCommand(a.Command) {
+		return false
+	}
+	return a.NetworkError() || a.ServerError()
+}
+
+// RetryPolicy decides whether and when a failed attempt of a request is retried
+type RetryPolicy interface {
+	// NextDelay returns the delay before the next attempt, or false if the request must not be retried
+	NextDelay(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// FixedDelays is a RetryPolicy waiting the given delays between the attempts,
+// the request fails once all of them are used up
+type FixedDelays struct {
+	Delays []time.Duration
+	// RetryNonIdempotent enables retrying commands like namecheap.domains.create after network and server errors
+	RetryNonIdempotent bool
+}
+
+func (p *FixedDelays) NextDelay(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > len(p.Delays) || !attempt.Retryable(p.RetryNonIdempotent) {
+		return 0, false
+	}
+	return p.Delays[attempt.Attempt-1], true
+}
+
+// ExponentialBackoff is a RetryPolicy increasing the delay between the attempts exponentially.
+// The zero value is usable and retries for up to two minutes.
+type ExponentialBackoff struct {
+	// InitialInterval is the delay before the first retry. Default value: 1s
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between two attempts. Default value: 60s
+	MaxInterval time.Duration
+	// Multiplier is applied to the delay after every attempt. Default value: 2
+	Multiplier float64
+	// Jitter randomizes the delays by up to the given fraction, from 0 to 1,
+	// so that clients throttled at the same time don't retry at the same time. Default value: 0
+	Jitter float64
+	// MaxElapsedTime stops retrying once the time since the first attempt would exceed it.
+	// Default value: 2m
+	MaxElapsedTime time.Duration
+	// MaxAttempts is the maximum number of attempts including the first one, 0 means no limit
+	MaxAttempts int
+	// RetryNonIdempotent enables retrying commands like namecheap.domains.create after network and server errors
+	RetryNonIdempotent bool
+}
+
+func (p *ExponentialBackoff) NextDelay(attempt RetryAttempt) (time.Duration, bool) {
+	if !attempt.Retryable(p.RetryNonIdempotent) {
+		return 0, false
+	}
+	if p.MaxAttempts > 0 && attempt.Attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	initialInterval := durationOrDefault(p.InitialInterval, time.Second)
+	maxInterval := durationOrDefault(p.MaxInterval, time.Minute)
+	maxElapsedTime := durationOrDefault(p.MaxElapsedTime, 2*time.Minute)
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	delay := float64(initialInterval) * math.Pow(multiplier, float64(attempt.Attempt-1))
+	if delay > float64(maxInterval) {
+		delay = float64(maxInterval)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay = delay * (1 - jitter + 2*jitter*rand.Float64())
+	}
+
+	if attempt.Elapsed+time.Duration(delay) > maxElapsedTime {
+		return 0, false
+	}
+
+	return time.Duration(delay), true
+}
+
+func durationOrDefault(d time.Duration, defaultValue time.Duration) time.Duration {
+	if d <= 0 {
+		return defaultValue
+	}
+	return d
+}
+
+// sleep pauses for the duration d or until ctx is done, whichever happens first
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,376 @@
+package namecheap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryAttempt(t *testing.T) {
+	throttledAPIError := newAPIError("namecheap.domains.create", []ErrorDetail{{Number: ErrorNumberTooManyRequests, Message: "Too many requests"}})
+	networkError := errors.New("connection reset by peer")
+
+	testCases := []struct {
+		name               string
+		attempt            RetryAttempt
+		retryNonIdempotent bool
+		retryable          bool
+	}{
+		{
+			name:      "throttled_status",
+			attempt:   RetryAttempt{Command: "namecheap.domains.create", StatusCode: http.StatusMethodNotAllowed},
+			retryable: true,
+		},
+		{
+			name:      "throttled_error_number",
+			attempt:   RetryAttempt{Command: "namecheap.domains.create", StatusCode: http.StatusOK, Err: throttledAPIError},
+			retryable: true,
+		},
+		{
+			name:      "network_error_idempotent",
+			attempt:   RetryAttempt{Command: "namecheap.domains.getList", Err: networkError},
+			retryable: true,
+		},
+		{
+			name:      "network_error_non_idempotent",
+			attempt:   RetryAttempt{Command: "namecheap.domains.create", Err: networkError},
+			retryable: false,
+		},
+		{
+			name:               "network_error_non_idempotent_allowed",
+			attempt:            RetryAttempt{Command: "namecheap.domains.create", Err: networkError},
+			retryNonIdempotent: true,
+			retryable:          true,
+		},
+		{
+			name:      "server_error_idempotent",
+			attempt:   RetryAttempt{Command: "namecheap.users.getBalances", StatusCode: http.StatusBadGateway, Err: networkError},
+			retryable: true,
+		},
+		{
+			name:      "server_error_non_idempotent",
+			attempt:   RetryAttempt{Command: "namecheap.users.createaddfundsrequest", StatusCode: http.StatusBadGateway, Err: networkError},
+			retryable: false,
+		},
+		{
+			name:      "context_cancelled",
+			attempt:   RetryAttempt{Command: "namecheap.domains.getList", Err: context.Canceled},
+			retryable: false,
+		},
+		{
+			name:      "context_deadline_exceeded",
+			attempt:   RetryAttempt{Command: "namecheap.domains.getList", Err: context.DeadlineExceeded},
+			retryable: false,
+		},
+		{
+			name:      "attempt_timeout",
+			attempt:   RetryAttempt{Command: "namecheap.domains.getList", Err: fmt.Errorf("%w: %w", ErrAttemptTimeout, context.DeadlineExceeded)},
+			retryable: true,
+		},
+		{
+			name:      "api_error",
+			attempt:   RetryAttempt{Command: "namecheap.domains.getInfo", StatusCode: http.StatusOK, Err: newAPIError("namecheap.domains.getInfo", []ErrorDetail{{Number: ErrorNumberDomainNotFound}})},
+			retryable: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.retryable, testCase.attempt.Retryable(testCase.retryNonIdempotent))
+		})
+	}
+}
+
+func TestIsIdempotentCommand(t *testing.T) {
+	assert.True(t, IsIdempotentCommand("namecheap.domains.getList"))
+	assert.True(t, IsIdempotentCommand("namecheap.domains.dns.setHosts"))
+	assert.False(t, IsIdempotentCommand("namecheap.domains.create"))
+	assert.False(t, IsIdempotentCommand("namecheap.domains.Renew"))
+	assert.False(t, IsIdempotentCommand("namecheap.users.createaddfundsrequest"))
+	assert.False(t, IsIdempotentCommand("namecheap.domains.transfer.create"))
+	assert.False(t, IsIdempotentCommand("namecheap.whoisguard.renew"))
+	assert.False(t, IsIdempotentCommand("namecheap.ssl.create"))
+	assert.False(t, IsIdempotentCommand("namecheap.ssl.renew"))
+	assert.False(t, IsIdempotentCommand("namecheap.ssl.reissue"))
+	assert.False(t, IsIdempotentCommand("namecheap.users.create"))
+	assert.False(t, IsIdempotentCommand("namecheap.users.address.create"))
+	assert.True(t, IsIdempotentCommand("namecheap.users.address.update"))
+}
+
+func TestFixedDelays(t *testing.T) {
+	policy := &FixedDelays{Delays: []time.Duration{time.Second, 2 * time.Second}}
+	throttled := RetryAttempt{Command: "namecheap.domains.getList", StatusCode: http.StatusMethodNotAllowed}
+
+	throttled.Attempt = 1
+	delay, retry := policy.NextDelay(throttled)
+	assert.True(t, retry)
+	assert.Equal(t, time.Second, delay)
+
+	throttled.Attempt = 2
+	delay, retry = policy.NextDelay(throttled)
+	assert.True(t, retry)
+	assert.Equal(t, 2*time.Second, delay)
+
+	throttled.Attempt = 3
+	_, retry = policy.NextDelay(throttled)
+	assert.False(t, retry)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	throttled := func(attempt int, elapsed time.Duration) RetryAttempt {
+		return RetryAttempt{Command: "namecheap.domains.getList", Attempt: attempt, Elapsed: elapsed, StatusCode: http.StatusMethodNotAllowed}
+	}
+
+	t.Run("exponential_delays", func(t *testing.T) {
+		policy := &ExponentialBackoff{InitialInterval: time.Second, Multiplier: 2, MaxInterval: 5 * time.Second}
+
+		expectedDelays := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+		for i, expectedDelay := range expectedDelays {
+			delay, retry := policy.NextDelay(throttled(i+1, 0))
+			assert.True(t, retry)
+			assert.Equal(t, expectedDelay, delay)
+		}
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		policy := &ExponentialBackoff{InitialInterval: time.Second, Jitter: 0.5}
+
+		for i := 0; i < 100; i++ {
+			delay, retry := policy.NextDelay(throttled(1, 0))
+			assert.True(t, retry)
+			assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+			assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+		}
+	})
+
+	t.Run("max_elapsed_time", func(t *testing.T) {
+		policy := &ExponentialBackoff{InitialInterval: time.Second, MaxElapsedTime: 10 * time.Second}
+
+		_, retry := policy.NextDelay(throttled(1, 8*time.Second))
+		assert.True(t, retry)
+
+		_, retry = policy.NextDelay(throttled(2, 9*time.Second))
+		assert.False(t, retry)
+	})
+
+	t.Run("max_attempts", func(t *testing.T) {
+		policy := &ExponentialBackoff{MaxAttempts: 2}
+
+		_, retry := policy.NextDelay(throttled(1, 0))
+		assert.True(t, retry)
+
+		_, retry = policy.NextDelay(throttled(2, 0))
+		assert.False(t, retry)
+	})
+
+	t.Run("non_idempotent_command", func(t *testing.T) {
+		policy := &ExponentialBackoff{}
+
+		_, retry := policy.NextDelay(RetryAttempt{Command: "namecheap.domains.renew", Attempt: 1, Err: errors.New("EOF")})
+		assert.False(t, retry)
+
+		policy.RetryNonIdempotent = true
+		_, retry = policy.NextDelay(RetryAttempt{Command: "namecheap.domains.renew", Attempt: 1, Err: errors.New("EOF")})
+		assert.True(t, retry)
+	})
+}
+
+func TestDoXMLRetries(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="utf-8"?>
+		<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.getbalances</RequestedCommand>
+			<CommandResponse Type="namecheap.users.getBalances">
+				<UserGetBalancesResult Currency="USD" AvailableBalance="4932.96" AccountBalance="4932.96" EarnedAmount="381.70" WithdrawableAmount="1243.36" FundsRequiredForAutoRenew="0.00" />
+			</CommandResponse>
+			<Server>PHX01SBAPIEXT05</Server>
+			<GMTTimeDifference>--4:00</GMTTimeDifference>
+			<ExecutionTime>0.024</ExecutionTime>
+		</ApiResponse>
+	`
+	fakeTooManyRequestsResponse := `
+		<?xml version="1.0" encoding="utf-8"?>
+		<ApiResponse Status="ERROR" xmlns="http://api.namecheap.com/xml.response">
+			<Errors>
+				<Error Number="500000">Too many requests</Error>
+			</Errors>
+			<Warnings />
+			<RequestedCommand>namecheap.users.getbalances</RequestedCommand>
+			<Server>PHX01SBAPIEXT05</Server>
+			<GMTTimeDifference>--4:00</GMTTimeDifference>
+			<ExecutionTime>0.024</ExecutionTime>
+		</ApiResponse>
+	`
+	fastRetries := &ExponentialBackoff{InitialInterval: time.Millisecond, MaxAttempts: 3}
+
+	t.Run("retry_server_error", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				writer.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(fastRetries))
+
+		result, err := client.Users.GetBalances()
+		if err != nil {
+			t.Fatal("Unable to get balances", err)
+		}
+
+		assert.Equal(t, int32(2), calls)
+		assert.Equal(t, "USD", *result.UserGetBalancesResult.Currency)
+	})
+
+	t.Run("retry_too_many_requests_error", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				_, _ = writer.Write([]byte(fakeTooManyRequestsResponse))
+				return
+			}
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(fastRetries))
+
+		result, err := client.Users.GetBalances()
+		if err != nil {
+			t.Fatal("Unable to get balances", err)
+		}
+
+		assert.Equal(t, int32(2), calls)
+		assert.Equal(t, "USD", *result.UserGetBalancesResult.Currency)
+	})
+
+	t.Run("too_many_requests_error_after_last_attempt", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeTooManyRequestsResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(fastRetries))
+
+		_, err := client.Users.GetBalances()
+
+		assert.True(t, IsTooManyRequests(err))
+	})
+
+	t.Run("no_retry_for_non_idempotent_command", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			writer.WriteHeader(http.StatusBadGateway)
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(fastRetries))
+
+		amount := 40.0
+		_, err := client.Users.CreateAddFundsRequest(&CreateAddFundsRequestArgs{
+			PaymentType: String("creditcard"),
+			Amount:      &amount,
+			ReturnURL:   String("https://domain.com"),
+		})
+
+		assert.EqualError(t, err, "unexpected response status: 502 Bad Gateway")
+		assert.Equal(t, int32(1), calls)
+	})
+
+	t.Run("no_retry_for_ssl_reissue", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			writer.WriteHeader(http.StatusBadGateway)
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(fastRetries))
+
+		_, err := client.SSL.Reissue(52556, &SSLActivateArgs{
+			CSR:               String("-----BEGIN CERTIFICATE REQUEST-----"),
+			AdminEmailAddress: String("john@domain1.com"),
+			DNSDCValidation:   Bool(true),
+		})
+
+		assert.EqualError(t, err, "unexpected response status: 502 Bad Gateway")
+		assert.Equal(t, int32(1), calls)
+	})
+
+	t.Run("no_retry_for_users_address_create", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			writer.WriteHeader(http.StatusBadGateway)
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(fastRetries))
+
+		_, err := client.UsersAddress.Create(&UsersAddressArgs{
+			AddressName: String("Office"),
+			Contact: &ContactInfo{
+				FirstName:     String("John"),
+				LastName:      String("Smith"),
+				Address1:      String("8939 S. cross Blvd"),
+				City:          String("california"),
+				StateProvince: String("ca"),
+				PostalCode:    String("90045"),
+				Country:       String("US"),
+				Phone:         String("+1.6613102107"),
+				EmailAddress:  String("john@gmail.com"),
+			},
+		})
+
+		assert.EqualError(t, err, "unexpected response status: 502 Bad Gateway")
+		assert.Equal(t, int32(1), calls)
+	})
+
+	t.Run("retries_are_not_serialized", func(t *testing.T) {
+		calls := int32(0)
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			if atomic.AddInt32(&calls, 1) <= 2 {
+				writer.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil, WithBaseURL(mockServer.URL), WithRetryPolicy(&FixedDelays{Delays: []time.Duration{200 * time.Millisecond}}))
+
+		start := time.Now()
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := client.Users.GetBalances()
+				assert.Nil(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Less(t, time.Since(start), 400*time.Millisecond)
+	})
+}
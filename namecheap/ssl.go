@@ -0,0 +1,70 @@
+package namecheap
+
+import "strings"
+
+// SSLService includes the following methods:
+// SSLService.Create - purchases a new SSL certificate
+// SSLService.GetList - returns a list of SSL certificates for the particular user
+// SSLService.GetInfo - gets information about the requested SSL certificate
+// SSLService.Activate - activates a purchased SSL certificate
+// SSLService.ParseCSR - parses a certificate signing request
+// SSLService.GetApproverEmailList - gets the approver email addresses of a domain
+// SSLService.Reissue - reissues an SSL certificate
+// SSLService.Renew - renews an SSL certificate
+// SSLService.ResendApproverEmail - resends the approver email
+// SSLService.ResendFulfillmentEmail - resends the fulfilment email containing the certificate
+// SSLService.EditDCVMethod - changes the domain control validation method
+// SSLService.RevokeCertificate - revokes a re-issued SSL certificate
+//
+// Every method has a ...Context variant taking a context.Context as its first argument.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/
+type SSLService service
+
+// SSLCertificateStatus is the status of an SSL certificate as returned by SSLService.GetList and SSLService.GetInfo.
+// The API doesn't use the same letter case everywhere, compare the values with Is.
+type SSLCertificateStatus string
+
+const (
+	SSLCertificateStatusNewPurchase      SSLCertificateStatus = "NewPurchase"
+	SSLCertificateStatusNewRenewal       SSLCertificateStatus = "NewRenewal"
+	SSLCertificateStatusProcessing       SSLCertificateStatus = "Processing"
+	SSLCertificateStatusEmailSent        SSLCertificateStatus = "EmailSent"
+	SSLCertificateStatusTechnicalProblem SSLCertificateStatus = "TechnicalProblem"
+	SSLCertificateStatusInProgress       SSLCertificateStatus = "InProgress"
+	SSLCertificateStatusCompleted        SSLCertificateStatus = "Completed"
+	SSLCertificateStatusActive           SSLCertificateStatus = "Active"
+	SSLCertificateStatusDeactivated      SSLCertificateStatus = "Deactivated"
+	SSLCertificateStatusCancelled        SSLCertificateStatus = "Cancelled"
+	SSLCertificateStatusReplaced         SSLCertificateStatus = "Replaced"
+	SSLCertificateStatusExpired          SSLCertificateStatus = "Expired"
+)
+
+// Is reports whether s and status are the same status regardless of the letter case
+func (s SSLCertificateStatus) Is(status SSLCertificateStatus) bool {
+	return strings.EqualFold(string(s), string(status))
+}
+
+// SSLHTTPDCValidation holds the file to publish for the HTTP based domain control validation
+type SSLHTTPDCValidation struct {
+	ValueAvailable *bool                        `xml:"ValueAvailable,attr"`
+	DNS            *[]SSLHTTPDCValidationDomain `xml:"DNS"`
+}
+
+type SSLHTTPDCValidationDomain struct {
+	Domain      *string `xml:"domain,attr"`
+	FileName    *string `xml:"FileName"`
+	FileContent *string `xml:"FileContent"`
+}
+
+// SSLDNSDCValidation holds the CNAME record to create for the DNS based domain control validation
+type SSLDNSDCValidation struct {
+	ValueAvailable *bool                       `xml:"ValueAvailable,attr"`
+	DNS            *[]SSLDNSDCValidationDomain `xml:"DNS"`
+}
+
+type SSLDNSDCValidationDomain struct {
+	Domain   *string `xml:"domain,attr"`
+	HostName *string `xml:"HostName"`
+	Target   *string `xml:"Target"`
+}
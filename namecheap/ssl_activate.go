@@ -0,0 +1,131 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// SSLActivateArgs are the arguments of SSLService.Activate and SSLService.Reissue.
+// Exactly one domain control validation method must be chosen: ApproverEmail, HTTPDCValidation or DNSDCValidation.
+type SSLActivateArgs struct {
+	// CSR is the certificate signing request
+	CSR *string
+	// AdminEmailAddress receives the issued certificate
+	AdminEmailAddress *string
+	// WebServerType is the server software the certificate is installed on, e.g. apacheopenssl
+	WebServerType *string
+
+	// ApproverEmail validates the domain through an email sent to one of the addresses
+	// returned by SSLService.GetApproverEmailList
+	ApproverEmail *string
+	// HTTPDCValidation validates the domain through a file published on the web server
+	HTTPDCValidation *bool
+	// DNSDCValidation validates the domain through a CNAME record
+	DNSDCValidation *bool
+
+	// Admin is the administrative contact required by some certificate types
+	Admin *ContactInfo
+}
+
+type SSLActivateResponse = Response[SSLActivateCommandResponse]
+
+type SSLActivateCommandResponse struct {
+	SSLActivateResult *SSLActivateResult `xml:"SSLActivateResult"`
+}
+
+type SSLActivateResult struct {
+	ID               *int                 `xml:"ID,attr"`
+	IsSuccess        *bool                `xml:"IsSuccess,attr"`
+	HttpDCValidation *SSLHTTPDCValidation `xml:"HttpDCValidation"` // nolint: stylecheck,revive
+	DNSDCValidation  *SSLDNSDCValidation  `xml:"DNSDCValidation"`
+}
+
+func validateSSLActivateArgs(args *SSLActivateArgs) error {
+	if args == nil {
+		return fmt.Errorf("SSLActivateArgs is required")
+	}
+	if args.CSR == nil || *args.CSR == "" {
+		return fmt.Errorf("CSR is required")
+	}
+	if args.AdminEmailAddress == nil || *args.AdminEmailAddress == "" {
+		return fmt.Errorf("AdminEmailAddress is required")
+	}
+
+	methods := 0
+	if args.ApproverEmail != nil && *args.ApproverEmail != "" {
+		methods++
+	}
+	if args.HTTPDCValidation != nil && *args.HTTPDCValidation {
+		methods++
+	}
+	if args.DNSDCValidation != nil && *args.DNSDCValidation {
+		methods++
+	}
+	if methods != 1 {
+		return fmt.Errorf("exactly one of ApproverEmail, HTTPDCValidation or DNSDCValidation is required")
+	}
+
+	return nil
+}
+
+func parseSSLActivateArgs(args *SSLActivateArgs) (*map[string]string, error) {
+	err := validateSSLActivateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{}
+
+	if args.Admin != nil {
+		addContactToParams(params, args.Admin, "Admin")
+	}
+
+	params["csr"] = *args.CSR
+	params["AdminEmailAddress"] = *args.AdminEmailAddress
+
+	if args.WebServerType != nil {
+		params["WebServerType"] = *args.WebServerType
+	}
+
+	if args.ApproverEmail != nil && *args.ApproverEmail != "" {
+		params["ApproverEmail"] = *args.ApproverEmail
+	}
+
+	if args.HTTPDCValidation != nil && *args.HTTPDCValidation {
+		params["HTTPDCValidation"] = strconv.FormatBool(true)
+	}
+
+	if args.DNSDCValidation != nil && *args.DNSDCValidation {
+		params["DNSDCValidation"] = strconv.FormatBool(true)
+	}
+
+	return &params, nil
+}
+
+// Activate activates a purchased SSL certificate. When HTTP or DNS validation is chosen,
+// the result contains the file or the CNAME record to publish.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/activate/
+func (s *SSLService) Activate(certificateID int, args *SSLActivateArgs) (*SSLActivateCommandResponse, error) {
+	return s.ActivateContext(context.Background(), certificateID, args)
+}
+
+// ActivateContext is like Activate but uses the provided context for the request and any retries
+func (s *SSLService) ActivateContext(ctx context.Context, certificateID int, args *SSLActivateArgs) (*SSLActivateCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.ssl.activate",
+	}
+
+	parsedArgs, err := parseSSLActivateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	params["CertificateID"] = strconv.Itoa(certificateID)
+
+	return doCommand[SSLActivateCommandResponse](ctx, s.client, params)
+}
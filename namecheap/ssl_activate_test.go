@@ -0,0 +1,122 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLActivate(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.activate</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.activate">
+				<SSLActivateResult ID="52556" IsSuccess="true">
+					<HttpDCValidation ValueAvailable="true">
+						<DNS domain="domain1.com">
+							<FileName>C7E5A.txt</FileName>
+							<FileContent>hash comodoca.com token</FileContent>
+						</DNS>
+					</HttpDCValidation>
+					<DNSDCValidation ValueAvailable="true">
+						<DNS domain="domain1.com">
+							<HostName>_c7e5a.domain1.com</HostName>
+							<Target>hash.token.comodoca.com</Target>
+						</DNS>
+					</DNSDCValidation>
+				</SSLActivateResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.Activate(52556, &SSLActivateArgs{CSR: String("-----BEGIN CERTIFICATE REQUEST-----"), AdminEmailAddress: String("john@domain1.com"), WebServerType: String("apacheopenssl"), HTTPDCValidation: Bool(true), Admin: &ContactInfo{FirstName: String("John"), LastName: String("Smith")}})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.activate", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.activate", sentBody.Get("Command"))
+		assert.Equal(t, "52556", sentBody.Get("CertificateID"))
+		assert.Equal(t, "-----BEGIN CERTIFICATE REQUEST-----", sentBody.Get("csr"))
+		assert.Equal(t, "john@domain1.com", sentBody.Get("AdminEmailAddress"))
+		assert.Equal(t, "apacheopenssl", sentBody.Get("WebServerType"))
+		assert.Equal(t, "true", sentBody.Get("HTTPDCValidation"))
+		assert.Equal(t, "John", sentBody.Get("AdminFirstName"))
+		assert.Equal(t, "Smith", sentBody.Get("AdminLastName"))
+		assert.False(t, sentBody.Has("ApproverEmail"))
+		assert.False(t, sentBody.Has("DNSDCValidation"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.Activate(52556, &SSLActivateArgs{CSR: String("-----BEGIN CERTIFICATE REQUEST-----"), AdminEmailAddress: String("john@domain1.com"), WebServerType: String("apacheopenssl"), HTTPDCValidation: Bool(true), Admin: &ContactInfo{FirstName: String("John"), LastName: String("Smith")}})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.activate", err)
+		}
+
+		result := response.SSLActivateResult
+		assert.Equal(t, 52556, *result.ID)
+		assert.True(t, *result.IsSuccess)
+		assert.True(t, *result.HttpDCValidation.ValueAvailable)
+		assert.Equal(t, []SSLHTTPDCValidationDomain{
+			{Domain: String("domain1.com"), FileName: String("C7E5A.txt"), FileContent: String("hash comodoca.com token")},
+		}, *result.HttpDCValidation.DNS)
+		assert.Equal(t, []SSLDNSDCValidationDomain{
+			{Domain: String("domain1.com"), HostName: String("_c7e5a.domain1.com"), Target: String("hash.token.comodoca.com")},
+		}, *result.DNSDCValidation.DNS)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.SSL.Activate(52556, nil)
+		assert.EqualError(t, err, "SSLActivateArgs is required")
+
+		_, err = client.SSL.Activate(52556, &SSLActivateArgs{AdminEmailAddress: String("john@domain1.com")})
+		assert.EqualError(t, err, "CSR is required")
+
+		_, err = client.SSL.Activate(52556, &SSLActivateArgs{CSR: String("csr")})
+		assert.EqualError(t, err, "AdminEmailAddress is required")
+
+		_, err = client.SSL.Activate(52556, &SSLActivateArgs{CSR: String("csr"), AdminEmailAddress: String("john@domain1.com")})
+		assert.EqualError(t, err, "exactly one of ApproverEmail, HTTPDCValidation or DNSDCValidation is required")
+
+		_, err = client.SSL.Activate(52556, &SSLActivateArgs{
+			CSR:               String("csr"),
+			AdminEmailAddress: String("john@domain1.com"),
+			ApproverEmail:     String("admin@domain1.com"),
+			DNSDCValidation:   Bool(true),
+		})
+		assert.EqualError(t, err, "exactly one of ApproverEmail, HTTPDCValidation or DNSDCValidation is required")
+	})
+}
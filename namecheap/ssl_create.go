@@ -0,0 +1,108 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type SSLCreateArgs struct {
+	// Type is the SSL product name, e.g. PositiveSSL
+	Type  *string
+	Years *int
+	// SANStoADD is the number of additional domains for multi-domain certificates
+	SANStoADD     *int
+	PromotionCode *string
+}
+
+type SSLCreateResponse = Response[SSLCreateCommandResponse]
+
+type SSLCreateCommandResponse struct {
+	SSLCreateResult *SSLCreateResult `xml:"SSLCreateResult"`
+}
+
+type SSLCreateResult struct {
+	IsSuccess      *bool                  `xml:"IsSuccess,attr"`
+	OrderID        *int                   `xml:"OrderId,attr"`
+	TransactionID  *int                   `xml:"TransactionId,attr"`
+	ChargedAmount  *string                `xml:"ChargedAmount,attr"`
+	SSLCertificate *SSLCreatedCertificate `xml:"SSLCertificate"`
+}
+
+type SSLCreatedCertificate struct {
+	CertificateID *int                  `xml:"CertificateID,attr"`
+	Created       *DateTime             `xml:"Created,attr"`
+	SSLType       *string               `xml:"SSLType,attr"`
+	Years         *int                  `xml:"Years,attr"`
+	Status        *SSLCertificateStatus `xml:"Status,attr"`
+}
+
+func (r SSLCreateResult) String() string {
+	orderID := 0
+	if r.OrderID != nil {
+		orderID = *r.OrderID
+	}
+	return fmt.Sprintf("{OrderID: %d, ChargedAmount: %s}", orderID, stringValue(r.ChargedAmount))
+}
+
+func validateSSLCreateArgs(args *SSLCreateArgs) error {
+	if args == nil {
+		return fmt.Errorf("SSLCreateArgs is required")
+	}
+	if args.Type == nil || *args.Type == "" {
+		return fmt.Errorf("Type is required")
+	}
+	if args.Years == nil {
+		return fmt.Errorf("Years is required")
+	}
+	if *args.Years < 1 || *args.Years > 5 {
+		return fmt.Errorf("Years must be between 1 and 5")
+	}
+	return nil
+}
+
+func parseSSLCreateArgs(args *SSLCreateArgs) (*map[string]string, error) {
+	err := validateSSLCreateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"Type":  *args.Type,
+		"Years": strconv.Itoa(*args.Years),
+	}
+
+	if args.SANStoADD != nil {
+		params["SANStoADD"] = strconv.Itoa(*args.SANStoADD)
+	}
+
+	if args.PromotionCode != nil {
+		params["PromotionCode"] = *args.PromotionCode
+	}
+
+	return &params, nil
+}
+
+// Create purchases a new SSL certificate. The purchase is charged to the account.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/create/
+func (s *SSLService) Create(args *SSLCreateArgs) (*SSLCreateCommandResponse, error) {
+	return s.CreateContext(context.Background(), args)
+}
+
+// CreateContext is like Create but uses the provided context for the request and any retries
+func (s *SSLService) CreateContext(ctx context.Context, args *SSLCreateArgs) (*SSLCreateCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.ssl.create",
+	}
+
+	parsedArgs, err := parseSSLCreateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[SSLCreateCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,97 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLCreate(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.create</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.create">
+				<SSLCreateResult IsSuccess="true" OrderId="1234" TransactionId="4321" ChargedAmount="17.8000">
+					<SSLCertificate CertificateID="7233" Created="03/10/2021" SSLType="PositiveSSL" Years="2" Status="NewPurchase" />
+				</SSLCreateResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.Create(&SSLCreateArgs{Type: String("PositiveSSL"), Years: Int(2), SANStoADD: Int(3), PromotionCode: String("PROMO123")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.create", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.create", sentBody.Get("Command"))
+		assert.Equal(t, "PositiveSSL", sentBody.Get("Type"))
+		assert.Equal(t, "2", sentBody.Get("Years"))
+		assert.Equal(t, "3", sentBody.Get("SANStoADD"))
+		assert.Equal(t, "PROMO123", sentBody.Get("PromotionCode"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.Create(&SSLCreateArgs{Type: String("PositiveSSL"), Years: Int(2), SANStoADD: Int(3), PromotionCode: String("PROMO123")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.create", err)
+		}
+
+		result := response.SSLCreateResult
+		assert.True(t, *result.IsSuccess)
+		assert.Equal(t, 1234, *result.OrderID)
+		assert.Equal(t, 4321, *result.TransactionID)
+		assert.Equal(t, "17.8000", *result.ChargedAmount)
+		assert.Equal(t, 7233, *result.SSLCertificate.CertificateID)
+		assert.Equal(t, "PositiveSSL", *result.SSLCertificate.SSLType)
+		assert.Equal(t, 2, *result.SSLCertificate.Years)
+		assert.True(t, result.SSLCertificate.Status.Is(SSLCertificateStatusNewPurchase))
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.SSL.Create(nil)
+		assert.EqualError(t, err, "SSLCreateArgs is required")
+
+		_, err = client.SSL.Create(&SSLCreateArgs{Years: Int(1)})
+		assert.EqualError(t, err, "Type is required")
+
+		_, err = client.SSL.Create(&SSLCreateArgs{Type: String("PositiveSSL")})
+		assert.EqualError(t, err, "Years is required")
+
+		_, err = client.SSL.Create(&SSLCreateArgs{Type: String("PositiveSSL"), Years: Int(6)})
+		assert.EqualError(t, err, "Years must be between 1 and 5")
+	})
+}
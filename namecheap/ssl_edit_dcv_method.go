@@ -0,0 +1,49 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// Domain control validation methods accepted by SSLService.EditDCVMethod besides an approver email address
+const (
+	SSLDCVMethodHTTP  = "HTTP_CSR_HASH"
+	SSLDCVMethodCNAME = "CNAME_CSR_HASH"
+)
+
+type SSLEditDCVMethodResponse = Response[SSLEditDCVMethodCommandResponse]
+
+type SSLEditDCVMethodCommandResponse struct {
+	SSLEditDCVMethodResult *SSLEditDCVMethodResult `xml:"SSLEditDCVMethodResult"`
+}
+
+type SSLEditDCVMethodResult struct {
+	ID               *int                 `xml:"ID,attr"`
+	IsSuccess        *bool                `xml:"IsSuccess,attr"`
+	HttpDCValidation *SSLHTTPDCValidation `xml:"HttpDCValidation"` // nolint: stylecheck,revive
+	DNSDCValidation  *SSLDNSDCValidation  `xml:"DNSDCValidation"`
+}
+
+// EditDCVMethod changes the domain control validation method of a certificate.
+// dcvMethod is an approver email address, SSLDCVMethodHTTP or SSLDCVMethodCNAME.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/edit-dcv-method/
+func (s *SSLService) EditDCVMethod(certificateID int, dcvMethod string) (*SSLEditDCVMethodCommandResponse, error) {
+	return s.EditDCVMethodContext(context.Background(), certificateID, dcvMethod)
+}
+
+// EditDCVMethodContext is like EditDCVMethod but uses the provided context for the request and any retries
+func (s *SSLService) EditDCVMethodContext(ctx context.Context, certificateID int, dcvMethod string) (*SSLEditDCVMethodCommandResponse, error) {
+	if dcvMethod == "" {
+		return nil, fmt.Errorf("DCVMethod is required")
+	}
+
+	params := map[string]string{
+		"Command":       "namecheap.ssl.editDCVMethod",
+		"CertificateID": strconv.Itoa(certificateID),
+		"DCVMethod":     dcvMethod,
+	}
+
+	return doCommand[SSLEditDCVMethodCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,98 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLEditDCVMethod(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.editDCVMethod</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.editDCVMethod">
+				<SSLEditDCVMethodResult ID="52556" IsSuccess="true">
+					<HttpDCValidation ValueAvailable="true">
+						<DNS domain="domain1.com">
+							<FileName>C7E5A.txt</FileName>
+							<FileContent>hash comodoca.com token</FileContent>
+						</DNS>
+					</HttpDCValidation>
+					<DNSDCValidation ValueAvailable="true">
+						<DNS domain="domain1.com">
+							<HostName>_c7e5a.domain1.com</HostName>
+							<Target>hash.token.comodoca.com</Target>
+						</DNS>
+					</DNSDCValidation>
+				</SSLEditDCVMethodResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.EditDCVMethod(52556, SSLDCVMethodCNAME)
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.editDCVMethod", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.editDCVMethod", sentBody.Get("Command"))
+		assert.Equal(t, "52556", sentBody.Get("CertificateID"))
+		assert.Equal(t, "CNAME_CSR_HASH", sentBody.Get("DCVMethod"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.EditDCVMethod(52556, SSLDCVMethodCNAME)
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.editDCVMethod", err)
+		}
+
+		result := response.SSLEditDCVMethodResult
+		assert.Equal(t, 52556, *result.ID)
+		assert.True(t, *result.IsSuccess)
+		assert.True(t, *result.HttpDCValidation.ValueAvailable)
+		assert.Equal(t, []SSLHTTPDCValidationDomain{
+			{Domain: String("domain1.com"), FileName: String("C7E5A.txt"), FileContent: String("hash comodoca.com token")},
+		}, *result.HttpDCValidation.DNS)
+		assert.Equal(t, []SSLDNSDCValidationDomain{
+			{Domain: String("domain1.com"), HostName: String("_c7e5a.domain1.com"), Target: String("hash.token.comodoca.com")},
+		}, *result.DNSDCValidation.DNS)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.SSL.EditDCVMethod(52556, "")
+		assert.EqualError(t, err, "DCVMethod is required")
+	})
+}
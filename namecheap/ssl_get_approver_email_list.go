@@ -0,0 +1,33 @@
+package namecheap
+
+import "context"
+
+type SSLGetApproverEmailListResponse = Response[SSLGetApproverEmailListCommandResponse]
+
+type SSLGetApproverEmailListCommandResponse struct {
+	GetApproverEmailListResult *SSLGetApproverEmailListResult `xml:"GetApproverEmailListResult"`
+}
+
+type SSLGetApproverEmailListResult struct {
+	DomainEmails  *[]string `xml:"Domainemails>email"`
+	GenericEmails *[]string `xml:"Genericemails>email"`
+	ManualEmails  *[]string `xml:"Manualemails>email"`
+}
+
+// GetApproverEmailList gets the email addresses which can approve the certificate of a domain
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/get-approver-email-list/
+func (s *SSLService) GetApproverEmailList(domain string, certificateType string) (*SSLGetApproverEmailListCommandResponse, error) {
+	return s.GetApproverEmailListContext(context.Background(), domain, certificateType)
+}
+
+// GetApproverEmailListContext is like GetApproverEmailList but uses the provided context for the request and any retries
+func (s *SSLService) GetApproverEmailListContext(ctx context.Context, domain string, certificateType string) (*SSLGetApproverEmailListCommandResponse, error) {
+	params := map[string]string{
+		"Command":         "namecheap.ssl.getApproverEmailList",
+		"DomainName":      domain,
+		"CertificateType": certificateType,
+	}
+
+	return doCommand[SSLGetApproverEmailListCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,81 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLGetApproverEmailList(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.getApproverEmailList</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.getApproverEmailList">
+				<GetApproverEmailListResult>
+					<Domainemails>
+						<email>john@domain1.com</email>
+					</Domainemails>
+					<Genericemails>
+						<email>admin@domain1.com</email>
+						<email>webmaster@domain1.com</email>
+					</Genericemails>
+					<Manualemails />
+				</GetApproverEmailListResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.GetApproverEmailList("domain1.com", "PositiveSSL")
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.getApproverEmailList", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.getApproverEmailList", sentBody.Get("Command"))
+		assert.Equal(t, "domain1.com", sentBody.Get("DomainName"))
+		assert.Equal(t, "PositiveSSL", sentBody.Get("CertificateType"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.GetApproverEmailList("domain1.com", "PositiveSSL")
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.getApproverEmailList", err)
+		}
+
+		result := response.GetApproverEmailListResult
+		assert.Equal(t, []string{"john@domain1.com"}, *result.DomainEmails)
+		assert.Equal(t, []string{"admin@domain1.com", "webmaster@domain1.com"}, *result.GenericEmails)
+		assert.Nil(t, result.ManualEmails)
+	})
+}
@@ -0,0 +1,85 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type SSLGetInfoArgs struct {
+	// ReturnCertificate includes the issued certificate into the response
+	ReturnCertificate *bool
+	// ReturnType is the format of the returned certificate, Individual or PKCS7
+	ReturnType *string
+}
+
+type SSLGetInfoResponse = Response[SSLGetInfoCommandResponse]
+
+type SSLGetInfoCommandResponse struct {
+	SSLGetInfoResult *SSLGetInfoResult `xml:"SSLGetInfoResult"`
+}
+
+type SSLGetInfoResult struct {
+	Status               *SSLCertificateStatus   `xml:"Status,attr"`
+	StatusDescription    *string                 `xml:"StatusDescription,attr"`
+	Type                 *string                 `xml:"Type,attr"`
+	IssuedOn             *DateTime               `xml:"IssuedOn,attr"`
+	Expires              *DateTime               `xml:"Expires,attr"`
+	ActivationExpireDate *DateTime               `xml:"ActivationExpireDate,attr"`
+	OrderID              *int                    `xml:"OrderId,attr"`
+	ReplacedBy           *int                    `xml:"ReplacedBy,attr"`
+	SANSCount            *int                    `xml:"SANSCount,attr"`
+	CertificateDetails   *SSLCertificateDetails  `xml:"CertificateDetails"`
+	Provider             *SSLCertificateProvider `xml:"Provider"`
+}
+
+type SSLCertificateDetails struct {
+	CSR                *string          `xml:"CSR"`
+	ApproverEmail      *string          `xml:"ApproverEmail"`
+	CommonName         *string          `xml:"CommonName"`
+	AdministratorName  *string          `xml:"AdministratorName"`
+	AdministratorEmail *string          `xml:"AdministratorEmail"`
+	Certificates       *SSLCertificates `xml:"Certificates"`
+}
+
+type SSLCertificates struct {
+	CertificateReturned *bool               `xml:"CertificateReturned,attr"`
+	ReturnType          *string             `xml:"ReturnType,attr"`
+	Certificate         *string             `xml:"Certificate"`
+	CaCertificates      *[]SSLCaCertificate `xml:"CaCertificates>Certificate"`
+}
+
+type SSLCaCertificate struct {
+	Type        *string `xml:"Type,attr"`
+	Certificate *string `xml:"Certificate"`
+}
+
+type SSLCertificateProvider struct {
+	OrderID *string `xml:"OrderID"`
+	Name    *string `xml:"Name"`
+}
+
+// GetInfo gets information about the requested SSL certificate, args may be nil
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/get-info/
+func (s *SSLService) GetInfo(certificateID int, args *SSLGetInfoArgs) (*SSLGetInfoCommandResponse, error) {
+	return s.GetInfoContext(context.Background(), certificateID, args)
+}
+
+// GetInfoContext is like GetInfo but uses the provided context for the request and any retries
+func (s *SSLService) GetInfoContext(ctx context.Context, certificateID int, args *SSLGetInfoArgs) (*SSLGetInfoCommandResponse, error) {
+	params := map[string]string{
+		"Command":       "namecheap.ssl.getInfo",
+		"CertificateID": strconv.Itoa(certificateID),
+	}
+
+	if args != nil {
+		if args.ReturnCertificate != nil {
+			params["Returncertificate"] = strconv.FormatBool(*args.ReturnCertificate)
+		}
+		if args.ReturnType != nil {
+			params["Returntype"] = *args.ReturnType
+		}
+	}
+
+	return doCommand[SSLGetInfoCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,111 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLGetInfo(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.getInfo</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.getInfo">
+				<SSLGetInfoResult Status="active" StatusDescription="Certificate is active" Type="PositiveSSL" IssuedOn="03/11/2021" Expires="03/11/2022" ActivationExpireDate="04/09/2021" OrderId="1234" ReplacedBy="0" SANSCount="0">
+					<CertificateDetails>
+						<CSR>-----BEGIN CERTIFICATE REQUEST-----</CSR>
+						<ApproverEmail>admin@domain1.com</ApproverEmail>
+						<CommonName>domain1.com</CommonName>
+						<AdministratorName>John Smith</AdministratorName>
+						<AdministratorEmail>john@domain1.com</AdministratorEmail>
+						<Certificates CertificateReturned="true" ReturnType="INDIVIDUAL">
+							<Certificate>-----BEGIN CERTIFICATE-----</Certificate>
+							<CaCertificates>
+								<Certificate Type="INTERMEDIATE">
+									<Certificate>-----BEGIN CERTIFICATE----- CA</Certificate>
+								</Certificate>
+							</CaCertificates>
+						</Certificates>
+					</CertificateDetails>
+					<Provider>
+						<OrderID>98765</OrderID>
+						<Name>COMODO</Name>
+					</Provider>
+				</SSLGetInfoResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.GetInfo(52556, &SSLGetInfoArgs{ReturnCertificate: Bool(true), ReturnType: String("Individual")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.getInfo", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.getInfo", sentBody.Get("Command"))
+		assert.Equal(t, "52556", sentBody.Get("CertificateID"))
+		assert.Equal(t, "true", sentBody.Get("Returncertificate"))
+		assert.Equal(t, "Individual", sentBody.Get("Returntype"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.GetInfo(52556, &SSLGetInfoArgs{ReturnCertificate: Bool(true), ReturnType: String("Individual")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.getInfo", err)
+		}
+
+		result := response.SSLGetInfoResult
+		assert.True(t, result.Status.Is(SSLCertificateStatusActive))
+		assert.Equal(t, "Certificate is active", *result.StatusDescription)
+		assert.Equal(t, "PositiveSSL", *result.Type)
+		assert.Equal(t, 2021, result.IssuedOn.Year())
+		assert.Equal(t, 2022, result.Expires.Year())
+		assert.Equal(t, 1234, *result.OrderID)
+		assert.Equal(t, 0, *result.ReplacedBy)
+		assert.Equal(t, 0, *result.SANSCount)
+
+		details := result.CertificateDetails
+		assert.Equal(t, "-----BEGIN CERTIFICATE REQUEST-----", *details.CSR)
+		assert.Equal(t, "admin@domain1.com", *details.ApproverEmail)
+		assert.Equal(t, "domain1.com", *details.CommonName)
+		assert.True(t, *details.Certificates.CertificateReturned)
+		assert.Equal(t, "-----BEGIN CERTIFICATE-----", *details.Certificates.Certificate)
+		assert.Equal(t, []SSLCaCertificate{
+			{Type: String("INTERMEDIATE"), Certificate: String("-----BEGIN CERTIFICATE----- CA")},
+		}, *details.Certificates.CaCertificates)
+
+		assert.Equal(t, "98765", *result.Provider.OrderID)
+		assert.Equal(t, "COMODO", *result.Provider.Name)
+	})
+}
@@ -0,0 +1,150 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var allowedSSLListTypeValues = []string{"ALL", "PROCESSING", "EMAILSENT", "TECHNICALPROBLEM", "INPROGRESS", "COMPLETED", "DEACTIVATED", "ACTIVE", "CANCELLED", "NEWPURCHASE", "NEWRENEWAL"}
+var allowedSSLSortByValues = []string{"PURCHASEDATE", "PURCHASEDATE_DESC", "SSLTYPE", "SSLTYPE_DESC", "EXPIREDATETIME", "EXPIREDATETIME_DESC", "HOST_NAME", "HOST_NAME_DESC"}
+
+type SSLGetListResponse = Response[SSLGetListCommandResponse]
+
+type SSLGetListCommandResponse struct {
+	SSLCertificates *[]SSLCertificate     `xml:"SSLListResult>SSL"`
+	Paging          *DomainsGetListPaging `xml:"Paging"`
+}
+
+type SSLCertificate struct {
+	CertificateID        *int                  `xml:"CertificateID,attr"`
+	HostName             *string               `xml:"HostName,attr"`
+	SSLType              *string               `xml:"SSLType,attr"`
+	PurchaseDate         *DateTime             `xml:"PurchaseDate,attr"`
+	ExpireDate           *DateTime             `xml:"ExpireDate,attr"`
+	ActivationExpireDate *DateTime             `xml:"ActivationExpireDate,attr"`
+	IsExpiredYN          *bool                 `xml:"IsExpiredYN,attr"`
+	Status               *SSLCertificateStatus `xml:"Status,attr"`
+}
+
+func (c SSLCertificate) String() string {
+	certificateID := 0
+	if c.CertificateID != nil {
+		certificateID = *c.CertificateID
+	}
+	status := ""
+	if c.Status != nil {
+		status = string(*c.Status)
+	}
+	return fmt.Sprintf("{CertificateID: %d, HostName: %s, SSLType: %s, Status: %s}",
+		certificateID, stringValue(c.HostName), stringValue(c.SSLType), status)
+}
+
+// SSLGetListArgs struct is an input arguments for SSLService.GetList function
+type SSLGetListArgs struct {
+	// Possible values are ALL, Processing, EmailSent, TechnicalProblem, InProgress, Completed, Deactivated,
+	// Active, Cancelled, NewPurchase, NewRenewal
+	// Default Value: ALL
+	ListType *string
+	// Keyword to look for in the certificate list
+	SearchTerm *string
+	// Page to return
+	// Default value: 1
+	Page *int
+	// Number of certificates to be listed on a page. Minimum value is 10, and maximum value is 100.
+	// Default value: 20
+	PageSize *int
+	// Possible values are PURCHASEDATE, PURCHASEDATE_DESC, SSLTYPE, SSLTYPE_DESC, EXPIREDATETIME, EXPIREDATETIME_DESC,
+	// Host_Name, Host_Name_DESC
+	SortBy *string
+}
+
+// GetList returns a list of SSL certificates for the particular user
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/get-list/
+func (s *SSLService) GetList(args *SSLGetListArgs) (*SSLGetListCommandResponse, error) {
+	return s.GetListContext(context.Background(), args)
+}
+
+// GetListContext is like GetList but uses the provided context for the request and any retries
+func (s *SSLService) GetListContext(ctx context.Context, args *SSLGetListArgs) (*SSLGetListCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.ssl.getList",
+	}
+
+	parsedArgsMap, err := parseSSLGetListArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range *parsedArgsMap {
+		params[k] = v
+	}
+
+	return doCommand[SSLGetListCommandResponse](ctx, s.client, params)
+}
+
+func parseSSLGetListArgs(args *SSLGetListArgs) (*map[string]string, error) {
+	params := map[string]string{}
+
+	if args == nil {
+		return &params, nil
+	}
+
+	if args.ListType != nil {
+		if isValidSSLListType(*args.ListType) {
+			params["ListType"] = *args.ListType
+		} else {
+			return nil, fmt.Errorf("invalid ListType value: %s", *args.ListType)
+		}
+	}
+
+	if args.SortBy != nil {
+		if isValidSSLSortBy(*args.SortBy) {
+			params["SortBy"] = *args.SortBy
+		} else {
+			return nil, fmt.Errorf("invalid SortBy value: %s", *args.SortBy)
+		}
+	}
+
+	if args.Page != nil {
+		if *args.Page > 0 {
+			params["Page"] = strconv.Itoa(*args.Page)
+		} else {
+			return nil, fmt.Errorf("invalid Page value: %d, minimum value is 1", *args.Page)
+		}
+	}
+
+	if args.PageSize != nil {
+		if *args.PageSize >= 10 && *args.PageSize <= 100 {
+			params["PageSize"] = strconv.Itoa(*args.PageSize)
+		} else {
+			return nil, fmt.Errorf("invalid PageSize value: %d, minimum value is 10, and maximum value is 100", *args.PageSize)
+		}
+	}
+
+	if args.SearchTerm != nil {
+		params["SearchTerm"] = *args.SearchTerm
+	}
+
+	return &params, nil
+}
+
+func isValidSSLListType(listType string) bool {
+	for _, value := range allowedSSLListTypeValues {
+		if strings.EqualFold(listType, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidSSLSortBy(sortBy string) bool {
+	for _, value := range allowedSSLSortByValues {
+		if strings.EqualFold(sortBy, value) {
+			return true
+		}
+	}
+	return false
+}
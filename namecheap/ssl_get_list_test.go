@@ -0,0 +1,103 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLGetList(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.getList</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.getList">
+				<SSLListResult>
+					<SSL CertificateID="52556" HostName="domain1.com" SSLType="PositiveSSL" PurchaseDate="03/10/2021" ExpireDate="03/10/2022" ActivationExpireDate="04/09/2021" IsExpiredYN="false" Status="active" />
+					<SSL CertificateID="52557" HostName="" SSLType="PositiveSSL" PurchaseDate="03/10/2021" ExpireDate="03/10/2022" ActivationExpireDate="04/09/2021" IsExpiredYN="false" Status="newpurchase" />
+				</SSLListResult>
+				<Paging>
+					<TotalItems>2</TotalItems>
+					<CurrentPage>1</CurrentPage>
+					<PageSize>20</PageSize>
+				</Paging>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.GetList(&SSLGetListArgs{ListType: String("Active"), SearchTerm: String("domain"), Page: Int(2), PageSize: Int(50), SortBy: String("EXPIREDATETIME")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.getList", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.getList", sentBody.Get("Command"))
+		assert.Equal(t, "Active", sentBody.Get("ListType"))
+		assert.Equal(t, "domain", sentBody.Get("SearchTerm"))
+		assert.Equal(t, "2", sentBody.Get("Page"))
+		assert.Equal(t, "50", sentBody.Get("PageSize"))
+		assert.Equal(t, "EXPIREDATETIME", sentBody.Get("SortBy"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.GetList(&SSLGetListArgs{ListType: String("Active"), SearchTerm: String("domain"), Page: Int(2), PageSize: Int(50), SortBy: String("EXPIREDATETIME")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.getList", err)
+		}
+
+		certificates := *response.SSLCertificates
+		assert.Len(t, certificates, 2)
+		assert.Equal(t, 52556, *certificates[0].CertificateID)
+		assert.Equal(t, "domain1.com", *certificates[0].HostName)
+		assert.Equal(t, "PositiveSSL", *certificates[0].SSLType)
+		assert.Equal(t, 2021, certificates[0].PurchaseDate.Year())
+		assert.Equal(t, 2022, certificates[0].ExpireDate.Year())
+		assert.False(t, *certificates[0].IsExpiredYN)
+		assert.True(t, certificates[0].Status.Is(SSLCertificateStatusActive))
+		assert.True(t, certificates[1].Status.Is(SSLCertificateStatusNewPurchase))
+		assert.Equal(t, 2, *response.Paging.TotalItems)
+	})
+
+	t.Run("request_data_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.SSL.GetList(&SSLGetListArgs{ListType: String("EXPIRING")})
+		assert.EqualError(t, err, "invalid ListType value: EXPIRING")
+
+		_, err = client.SSL.GetList(&SSLGetListArgs{SortBy: String("NAME")})
+		assert.EqualError(t, err, "invalid SortBy value: NAME")
+
+		_, err = client.SSL.GetList(&SSLGetListArgs{PageSize: Int(5)})
+		assert.EqualError(t, err, "invalid PageSize value: 5, minimum value is 10, and maximum value is 100")
+	})
+}
@@ -0,0 +1,46 @@
+package namecheap
+
+import "context"
+
+type SSLParseCSRResponse = Response[SSLParseCSRCommandResponse]
+
+type SSLParseCSRCommandResponse struct {
+	SSLParseCSRResult *SSLParseCSRResult `xml:"SSLParseCSRResult"`
+}
+
+type SSLParseCSRResult struct {
+	CSRDetails *SSLCSRDetails `xml:"CSRDetails"`
+}
+
+type SSLCSRDetails struct {
+	CommonName       *string `xml:"CommonName"`
+	DomainName       *string `xml:"DomainName"`
+	Country          *string `xml:"Country"`
+	OrganisationUnit *string `xml:"OrganisationUnit"`
+	Organisation     *string `xml:"Organisation"`
+	ValidTrueDomain  *bool   `xml:"ValidTrueDomain"`
+	State            *string `xml:"State"`
+	Locality         *string `xml:"Locality"`
+	Email            *string `xml:"Email"`
+}
+
+// ParseCSR parses a certificate signing request, certificateType is optional
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/parse-csr/
+func (s *SSLService) ParseCSR(csr string, certificateType string) (*SSLParseCSRCommandResponse, error) {
+	return s.ParseCSRContext(context.Background(), csr, certificateType)
+}
+
+// ParseCSRContext is like ParseCSR but uses the provided context for the request and any retries
+func (s *SSLService) ParseCSRContext(ctx context.Context, csr string, certificateType string) (*SSLParseCSRCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.ssl.parseCSR",
+		"csr":     csr,
+	}
+
+	if certificateType != "" {
+		params["CertificateType"] = certificateType
+	}
+
+	return doCommand[SSLParseCSRCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,90 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLParseCSR(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.parseCSR</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.parseCSR">
+				<SSLParseCSRResult>
+					<CSRDetails>
+						<CommonName>domain1.com</CommonName>
+						<DomainName>domain1.com</DomainName>
+						<Country>US</Country>
+						<OrganisationUnit>IT</OrganisationUnit>
+						<Organisation>Domain Inc</Organisation>
+						<ValidTrueDomain>true</ValidTrueDomain>
+						<State>CA</State>
+						<Locality>Los Angeles</Locality>
+						<Email>admin@domain1.com</Email>
+					</CSRDetails>
+				</SSLParseCSRResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.ParseCSR("-----BEGIN CERTIFICATE REQUEST-----", "PositiveSSL")
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.parseCSR", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.parseCSR", sentBody.Get("Command"))
+		assert.Equal(t, "-----BEGIN CERTIFICATE REQUEST-----", sentBody.Get("csr"))
+		assert.Equal(t, "PositiveSSL", sentBody.Get("CertificateType"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.ParseCSR("-----BEGIN CERTIFICATE REQUEST-----", "PositiveSSL")
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.parseCSR", err)
+		}
+
+		details := response.SSLParseCSRResult.CSRDetails
+		assert.Equal(t, "domain1.com", *details.CommonName)
+		assert.Equal(t, "domain1.com", *details.DomainName)
+		assert.Equal(t, "US", *details.Country)
+		assert.Equal(t, "IT", *details.OrganisationUnit)
+		assert.Equal(t, "Domain Inc", *details.Organisation)
+		assert.True(t, *details.ValidTrueDomain)
+		assert.Equal(t, "CA", *details.State)
+		assert.Equal(t, "Los Angeles", *details.Locality)
+		assert.Equal(t, "admin@domain1.com", *details.Email)
+	})
+}
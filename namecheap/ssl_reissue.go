@@ -0,0 +1,45 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type SSLReissueResponse = Response[SSLReissueCommandResponse]
+
+type SSLReissueCommandResponse struct {
+	SSLReissueResult *SSLReissueResult `xml:"SSLReissueResult"`
+}
+
+type SSLReissueResult struct {
+	ID               *int                 `xml:"ID,attr"`
+	IsSuccess        *bool                `xml:"IsSuccess,attr"`
+	HttpDCValidation *SSLHTTPDCValidation `xml:"HttpDCValidation"` // nolint: stylecheck,revive
+	DNSDCValidation  *SSLDNSDCValidation  `xml:"DNSDCValidation"`
+}
+
+// Reissue reissues an SSL certificate with a new CSR, it accepts the same arguments as Activate
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/reissue/
+func (s *SSLService) Reissue(certificateID int, args *SSLActivateArgs) (*SSLReissueCommandResponse, error) {
+	return s.ReissueContext(context.Background(), certificateID, args)
+}
+
+// ReissueContext is like Reissue but uses the provided context for the request and any retries
+func (s *SSLService) ReissueContext(ctx context.Context, certificateID int, args *SSLActivateArgs) (*SSLReissueCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.ssl.reissue",
+	}
+
+	parsedArgs, err := parseSSLActivateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	params["CertificateID"] = strconv.Itoa(certificateID)
+
+	return doCommand[SSLReissueCommandResponse](ctx, s.client, params)
+}
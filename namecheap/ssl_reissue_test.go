@@ -0,0 +1,91 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLReissue(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.reissue</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.reissue">
+				<SSLReissueResult ID="52557" IsSuccess="true">
+					<HttpDCValidation ValueAvailable="true">
+						<DNS domain="domain1.com">
+							<FileName>C7E5A.txt</FileName>
+							<FileContent>hash comodoca.com token</FileContent>
+						</DNS>
+					</HttpDCValidation>
+					<DNSDCValidation ValueAvailable="true">
+						<DNS domain="domain1.com">
+							<HostName>_c7e5a.domain1.com</HostName>
+							<Target>hash.token.comodoca.com</Target>
+						</DNS>
+					</DNSDCValidation>
+				</SSLReissueResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.Reissue(52556, &SSLActivateArgs{CSR: String("-----BEGIN CERTIFICATE REQUEST-----"), AdminEmailAddress: String("john@domain1.com"), DNSDCValidation: Bool(true)})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.reissue", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.reissue", sentBody.Get("Command"))
+		assert.Equal(t, "52556", sentBody.Get("CertificateID"))
+		assert.Equal(t, "true", sentBody.Get("DNSDCValidation"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.Reissue(52556, &SSLActivateArgs{CSR: String("-----BEGIN CERTIFICATE REQUEST-----"), AdminEmailAddress: String("john@domain1.com"), DNSDCValidation: Bool(true)})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.reissue", err)
+		}
+
+		result := response.SSLReissueResult
+		assert.Equal(t, 52557, *result.ID)
+		assert.True(t, *result.IsSuccess)
+		assert.True(t, *result.HttpDCValidation.ValueAvailable)
+		assert.Equal(t, []SSLHTTPDCValidationDomain{
+			{Domain: String("domain1.com"), FileName: String("C7E5A.txt"), FileContent: String("hash comodoca.com token")},
+		}, *result.HttpDCValidation.DNS)
+		assert.Equal(t, []SSLDNSDCValidationDomain{
+			{Domain: String("domain1.com"), HostName: String("_c7e5a.domain1.com"), Target: String("hash.token.comodoca.com")},
+		}, *result.DNSDCValidation.DNS)
+	})
+}
@@ -0,0 +1,96 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type SSLRenewArgs struct {
+	// SSLType is the SSL product name, e.g. PositiveSSL
+	SSLType       *string
+	Years         *int
+	PromotionCode *string
+}
+
+type SSLRenewResponse = Response[SSLRenewCommandResponse]
+
+type SSLRenewCommandResponse struct {
+	SSLRenewResult *SSLRenewResult `xml:"SSLRenewResult"`
+}
+
+type SSLRenewResult struct {
+	CertificateID *int    `xml:"CertificateID,attr"`
+	Years         *int    `xml:"Years,attr"`
+	SSLType       *string `xml:"SSLType,attr"`
+	OrderID       *int    `xml:"OrderId,attr"`
+	TransactionID *int    `xml:"TransactionId,attr"`
+	ChargedAmount *string `xml:"ChargedAmount,attr"`
+}
+
+func (r SSLRenewResult) String() string {
+	certificateID := 0
+	if r.CertificateID != nil {
+		certificateID = *r.CertificateID
+	}
+	return fmt.Sprintf("{CertificateID: %d, SSLType: %s, ChargedAmount: %s}", certificateID, stringValue(r.SSLType), stringValue(r.ChargedAmount))
+}
+
+func validateSSLRenewArgs(args *SSLRenewArgs) error {
+	if args == nil {
+		return fmt.Errorf("SSLRenewArgs is required")
+	}
+	if args.SSLType == nil || *args.SSLType == "" {
+		return fmt.Errorf("SSLType is required")
+	}
+	if args.Years == nil {
+		return fmt.Errorf("Years is required")
+	}
+	if *args.Years < 1 || *args.Years > 5 {
+		return fmt.Errorf("Years must be between 1 and 5")
+	}
+	return nil
+}
+
+func parseSSLRenewArgs(args *SSLRenewArgs) (*map[string]string, error) {
+	err := validateSSLRenewArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"SSLType": *args.SSLType,
+		"Years":   strconv.Itoa(*args.Years),
+	}
+
+	if args.PromotionCode != nil {
+		params["PromotionCode"] = *args.PromotionCode
+	}
+
+	return &params, nil
+}
+
+// Renew renews an SSL certificate. The renewal is charged to the account.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/renew/
+func (s *SSLService) Renew(certificateID int, args *SSLRenewArgs) (*SSLRenewCommandResponse, error) {
+	return s.RenewContext(context.Background(), certificateID, args)
+}
+
+// RenewContext is like Renew but uses the provided context for the request and any retries
+func (s *SSLService) RenewContext(ctx context.Context, certificateID int, args *SSLRenewArgs) (*SSLRenewCommandResponse, error) {
+	params := map[string]string{
+		"Command":       "namecheap.ssl.renew",
+		"CertificateID": strconv.Itoa(certificateID),
+	}
+
+	parsedArgs, err := parseSSLRenewArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[SSLRenewCommandResponse](ctx, s.client, params)
+}
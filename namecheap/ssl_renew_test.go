@@ -0,0 +1,90 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLRenew(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.ssl.renew</RequestedCommand>
+			<CommandResponse Type="namecheap.ssl.renew">
+				<SSLRenewResult CertificateID="52558" Years="1" SSLType="PositiveSSL" OrderId="1234" TransactionId="4321" ChargedAmount="8.8800" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.SSL.Renew(52556, &SSLRenewArgs{SSLType: String("PositiveSSL"), Years: Int(1), PromotionCode: String("PROMO123")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.renew", err)
+		}
+
+		assert.Equal(t, "namecheap.ssl.renew", sentBody.Get("Command"))
+		assert.Equal(t, "52556", sentBody.Get("CertificateID"))
+		assert.Equal(t, "PositiveSSL", sentBody.Get("SSLType"))
+		assert.Equal(t, "1", sentBody.Get("Years"))
+		assert.Equal(t, "PROMO123", sentBody.Get("PromotionCode"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.SSL.Renew(52556, &SSLRenewArgs{SSLType: String("PositiveSSL"), Years: Int(1), PromotionCode: String("PROMO123")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.ssl.renew", err)
+		}
+
+		result := response.SSLRenewResult
+		assert.Equal(t, 52558, *result.CertificateID)
+		assert.Equal(t, 1, *result.Years)
+		assert.Equal(t, "PositiveSSL", *result.SSLType)
+		assert.Equal(t, 1234, *result.OrderID)
+		assert.Equal(t, 4321, *result.TransactionID)
+		assert.Equal(t, "8.8800", *result.ChargedAmount)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.SSL.Renew(52556, nil)
+		assert.EqualError(t, err, "SSLRenewArgs is required")
+
+		_, err = client.SSL.Renew(52556, &SSLRenewArgs{Years: Int(1)})
+		assert.EqualError(t, err, "SSLType is required")
+
+		_, err = client.SSL.Renew(52556, &SSLRenewArgs{SSLType: String("PositiveSSL"), Years: Int(0)})
+		assert.EqualError(t, err, "Years must be between 1 and 5")
+	})
+}
@@ -0,0 +1,34 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type SSLResendApproverEmailResponse = Response[SSLResendApproverEmailCommandResponse]
+
+type SSLResendApproverEmailCommandResponse struct {
+	SSLResendApproverEmailResult *SSLResendApproverEmailResult `xml:"SSLResendApproverEmailResult"`
+}
+
+type SSLResendApproverEmailResult struct {
+	ID        *int  `xml:"ID,attr"`
+	IsSuccess *bool `xml:"IsSuccess,attr"`
+}
+
+// ResendApproverEmail resends the approver email of a certificate validated by email
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/resend-approver-email/
+func (s *SSLService) ResendApproverEmail(certificateID int) (*SSLResendApproverEmailCommandResponse, error) {
+	return s.ResendApproverEmailContext(context.Background(), certificateID)
+}
+
+// ResendApproverEmailContext is like ResendApproverEmail but uses the provided context for the request and any retries
+func (s *SSLService) ResendApproverEmailContext(ctx context.Context, certificateID int) (*SSLResendApproverEmailCommandResponse, error) {
+	params := map[string]string{
+		"Command":       "namecheap.ssl.resendApproverEmail",
+		"CertificateID": strconv.Itoa(certificateID),
+	}
+
+	return doCommand[SSLResendApproverEmailCommandResponse](ctx, s.client, params)
+}
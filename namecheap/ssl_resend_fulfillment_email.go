@@ -0,0 +1,34 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type SSLResendFulfillmentEmailResponse = Response[SSLResendFulfillmentEmailCommandResponse]
+
+type SSLResendFulfillmentEmailCommandResponse struct {
+	SSLResendFulfillmentEmailResult *SSLResendFulfillmentEmailResult `xml:"SSLResendFulfillmentEmailResult"`
+}
+
+type SSLResendFulfillmentEmailResult struct {
+	ID        *int  `xml:"ID,attr"`
+	IsSuccess *bool `xml:"IsSuccess,attr"`
+}
+
+// ResendFulfillmentEmail resends the fulfilment email containing the issued certificate
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/resend-fulfillment-email/
+func (s *SSLService) ResendFulfillmentEmail(certificateID int) (*SSLResendFulfillmentEmailCommandResponse, error) {
+	return s.ResendFulfillmentEmailContext(context.Background(), certificateID)
+}
+
+// ResendFulfillmentEmailContext is like ResendFulfillmentEmail but uses the provided context for the request and any retries
+func (s *SSLService) ResendFulfillmentEmailContext(ctx context.Context, certificateID int) (*SSLResendFulfillmentEmailCommandResponse, error) {
+	params := map[string]string{
+		"Command":       "namecheap.ssl.resendfulfillmentemail",
+		"CertificateID": strconv.Itoa(certificateID),
+	}
+
+	return doCommand[SSLResendFulfillmentEmailCommandResponse](ctx, s.client, params)
+}
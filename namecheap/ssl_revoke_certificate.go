@@ -0,0 +1,35 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type SSLRevokeCertificateResponse = Response[SSLRevokeCertificateCommandResponse]
+
+type SSLRevokeCertificateCommandResponse struct {
+	RevokeCertificateResult *SSLRevokeCertificateResult `xml:"RevokeCertificateResult"`
+}
+
+type SSLRevokeCertificateResult struct {
+	ID        *int  `xml:"ID,attr"`
+	IsSuccess *bool `xml:"IsSuccess,attr"`
+}
+
+// RevokeCertificate revokes a re-issued SSL certificate
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/ssl/revoke-certificate/
+func (s *SSLService) RevokeCertificate(certificateID int, certificateType string) (*SSLRevokeCertificateCommandResponse, error) {
+	return s.RevokeCertificateContext(context.Background(), certificateID, certificateType)
+}
+
+// RevokeCertificateContext is like RevokeCertificate but uses the provided context for the request and any retries
+func (s *SSLService) RevokeCertificateContext(ctx context.Context, certificateID int, certificateType string) (*SSLRevokeCertificateCommandResponse, error) {
+	params := map[string]string{
+		"Command":         "namecheap.ssl.revokecertificate",
+		"CertificateID":   strconv.Itoa(certificateID),
+		"CertificateType": certificateType,
+	}
+
+	return doCommand[SSLRevokeCertificateCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,13 @@
+package namecheap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLCertificateStatus(t *testing.T) {
+	assert.True(t, SSLCertificateStatus("active").Is(SSLCertificateStatusActive))
+	assert.True(t, SSLCertificateStatus("NEWPURCHASE").Is(SSLCertificateStatusNewPurchase))
+	assert.False(t, SSLCertificateStatus("active").Is(SSLCertificateStatusDeactivated))
+}
@@ -5,6 +5,13 @@ package namecheap
 // UsersService.GetPricing - returns pricing information for a requested product type
 // UsersService.CreateAddFundsRequest - creates a request to add funds through a credit card
 // UsersService.GetAddFundsStatus - gets the status of add funds request
+// UsersService.Create - creates a new user account
+// UsersService.Update - updates the contact information of the user
+// UsersService.ChangePassword - changes the password of the user
+// UsersService.ResetPassword - sends a password reset link to the user
+// UsersService.Login - validates the password of the user
+//
+// Every method has a ...Context variant taking a context.Context as its first argument.
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/users/
 type UsersService service
@@ -0,0 +1,84 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+// UsersAddressService includes the following methods:
+// UsersAddressService.Create - creates a new address for the user
+// UsersAddressService.Update - updates an address of the user
+// UsersAddressService.Delete - deletes an address of the user
+// UsersAddressService.GetInfo - gets the details of an address
+// UsersAddressService.GetList - gets the list of addresses of the user
+// UsersAddressService.SetDefault - sets the default address of the user
+//
+// A saved address can be referenced by CreateArgs.AddressID and SetContactsArgs.AddressID
+// instead of passing the contacts.
+//
+// Every method has a ...Context variant taking a context.Context as its first argument.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users-address/
+type UsersAddressService service
+
+// UsersAddressArgs are the arguments of UsersAddressService.Create and UsersAddressService.Update
+type UsersAddressArgs struct {
+	AddressName *string
+	// Default makes the address the default one of the user
+	Default *bool
+
+	Contact *ContactInfo
+}
+
+func parseUsersAddressArgs(args *UsersAddressArgs) (*map[string]string, error) {
+	params := map[string]string{}
+
+	err := validateUsersAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	addUserContactToParams(params, args.Contact)
+	if args.Contact.StateProvinceChoice != nil {
+		params["StateProvinceChoice"] = *args.Contact.StateProvinceChoice
+	}
+
+	params["AddressName"] = *args.AddressName
+
+	if args.Default != nil {
+		if *args.Default {
+			params["DefaultYN"] = "1"
+		} else {
+			params["DefaultYN"] = "0"
+		}
+	}
+
+	return &params, nil
+}
+
+// fillContacts sets the nil contacts to the saved address, the address is only fetched when a contact is missing
+func (s *UsersAddressService) fillContacts(ctx context.Context, addressID int, contacts ...**ContactInfo) error {
+	var address *ContactInfo
+
+	for _, contact := range contacts {
+		if *contact != nil {
+			continue
+		}
+
+		if address == nil {
+			info, err := s.GetInfoContext(ctx, addressID)
+			if err != nil {
+				return err
+			}
+			if info.GetAddressInfoResult == nil {
+				return fmt.Errorf("address %d not found", addressID)
+			}
+			address = info.GetAddressInfoResult.ContactInfo()
+		}
+
+		copied := *address
+		*contact = &copied
+	}
+
+	return nil
+}
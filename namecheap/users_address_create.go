@@ -0,0 +1,52 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+type UsersAddressCreateResponse = Response[UsersAddressCreateCommandResponse]
+
+type UsersAddressCreateCommandResponse struct {
+	AddressCreateResult *UsersAddressCreateResult `xml:"AddressCreateResult"`
+}
+
+type UsersAddressCreateResult struct {
+	Success     *bool   `xml:"Success,attr"`
+	AddressID   *int    `xml:"AddressId,attr"`
+	AddressName *string `xml:"AddressName,attr"`
+}
+
+func validateUsersAddressArgs(args *UsersAddressArgs) error {
+	if args == nil {
+		return fmt.Errorf("UsersAddressArgs is required")
+	}
+	if args.AddressName == nil || *args.AddressName == "" {
+		return fmt.Errorf("AddressName is required")
+	}
+	return validateUserContactInfo(args.Contact)
+}
+
+// Create creates a new address for the user
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users-address/create/
+func (s *UsersAddressService) Create(args *UsersAddressArgs) (*UsersAddressCreateCommandResponse, error) {
+	return s.CreateContext(context.Background(), args)
+}
+
+// CreateContext is like Create but uses the provided context for the request and any retries
+func (s *UsersAddressService) CreateContext(ctx context.Context, args *UsersAddressArgs) (*UsersAddressCreateCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.users.address.create",
+	}
+
+	parsedArgs, err := parseUsersAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[UsersAddressCreateCommandResponse](ctx, s.client, params)
+}
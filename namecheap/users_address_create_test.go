@@ -0,0 +1,89 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersAddressCreate(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.address.create</RequestedCommand>
+			<CommandResponse Type="namecheap.users.address.create">
+				<AddressCreateResult Success="true" AddressId="1504" AddressName="Office" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.UsersAddress.Create(&UsersAddressArgs{AddressName: String("Office"), Default: Bool(true), Contact: &ContactInfo{FirstName: String("John"), LastName: String("Smith"), Address1: String("8939 S. cross Blvd"), City: String("california"), StateProvince: String("ca"), StateProvinceChoice: String("S"), PostalCode: String("90045"), Country: String("US"), Phone: String("+1.6613102107"), EmailAddress: String("john@gmail.com"), OrganizationName: String("NameCheap.com")}})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.address.create", err)
+		}
+
+		assert.Equal(t, "namecheap.users.address.create", sentBody.Get("Command"))
+		assert.Equal(t, "Office", sentBody.Get("AddressName"))
+		assert.Equal(t, "1", sentBody.Get("DefaultYN"))
+		assert.Equal(t, "John", sentBody.Get("FirstName"))
+		assert.Equal(t, "90045", sentBody.Get("Zip"))
+		assert.Equal(t, "S", sentBody.Get("StateProvinceChoice"))
+		assert.Equal(t, "NameCheap.com", sentBody.Get("Organization"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.UsersAddress.Create(&UsersAddressArgs{AddressName: String("Office"), Default: Bool(true), Contact: &ContactInfo{FirstName: String("John"), LastName: String("Smith"), Address1: String("8939 S. cross Blvd"), City: String("california"), StateProvince: String("ca"), StateProvinceChoice: String("S"), PostalCode: String("90045"), Country: String("US"), Phone: String("+1.6613102107"), EmailAddress: String("john@gmail.com"), OrganizationName: String("NameCheap.com")}})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.address.create", err)
+		}
+
+		result := response.AddressCreateResult
+		assert.True(t, *result.Success)
+		assert.Equal(t, 1504, *result.AddressID)
+		assert.Equal(t, "Office", *result.AddressName)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.UsersAddress.Create(nil)
+		assert.EqualError(t, err, "UsersAddressArgs is required")
+
+		_, err = client.UsersAddress.Create(&UsersAddressArgs{Contact: &ContactInfo{}})
+		assert.EqualError(t, err, "AddressName is required")
+
+		_, err = client.UsersAddress.Create(&UsersAddressArgs{AddressName: String("Office")})
+		assert.EqualError(t, err, "contact information is required")
+	})
+}
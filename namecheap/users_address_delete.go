@@ -0,0 +1,34 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type UsersAddressDeleteResponse = Response[UsersAddressDeleteCommandResponse]
+
+type UsersAddressDeleteCommandResponse struct {
+	AddressDeleteResult *UsersAddressDeleteResult `xml:"AddressDeleteResult"`
+}
+
+type UsersAddressDeleteResult struct {
+	Success   *bool `xml:"Success,attr"`
+	AddressID *int  `xml:"AddressId,attr"`
+}
+
+// Delete deletes an address of the user
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users-address/delete/
+func (s *UsersAddressService) Delete(addressID int) (*UsersAddressDeleteCommandResponse, error) {
+	return s.DeleteContext(context.Background(), addressID)
+}
+
+// DeleteContext is like Delete but uses the provided context for the request and any retries
+func (s *UsersAddressService) DeleteContext(ctx context.Context, addressID int) (*UsersAddressDeleteCommandResponse, error) {
+	params := map[string]string{
+		"Command":   "namecheap.users.address.delete",
+		"AddressId": strconv.Itoa(addressID),
+	}
+
+	return doCommand[UsersAddressDeleteCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,69 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersAddressDelete(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.address.delete</RequestedCommand>
+			<CommandResponse Type="namecheap.users.address.delete">
+				<AddressDeleteResult Success="true" AddressId="1504" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.UsersAddress.Delete(1504)
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.address.delete", err)
+		}
+
+		assert.Equal(t, "namecheap.users.address.delete", sentBody.Get("Command"))
+		assert.Equal(t, "1504", sentBody.Get("AddressId"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.UsersAddress.Delete(1504)
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.address.delete", err)
+		}
+
+		assert.True(t, *response.AddressDeleteResult.Success)
+		assert.Equal(t, 1504, *response.AddressDeleteResult.AddressID)
+	})
+}
@@ -0,0 +1,72 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type UsersAddressGetInfoResponse = Response[UsersAddressGetInfoCommandResponse]
+
+type UsersAddressGetInfoCommandResponse struct {
+	GetAddressInfoResult *UsersAddressGetInfoResult `xml:"GetAddressInfoResult"`
+}
+
+type UsersAddressGetInfoResult struct {
+	AddressID           *int    `xml:"AddressId"`
+	UserName            *string `xml:"UserName"`
+	AddressName         *string `xml:"AddressName"`
+	Default             *bool   `xml:"Default_YN"`
+	FirstName           *string `xml:"FirstName"`
+	LastName            *string `xml:"LastName"`
+	JobTitle            *string `xml:"JobTitle"`
+	Organization        *string `xml:"Organization"`
+	Address1            *string `xml:"Address1"`
+	Address2            *string `xml:"Address2"`
+	City                *string `xml:"City"`
+	StateProvince       *string `xml:"StateProvince"`
+	StateProvinceChoice *string `xml:"StateProvinceChoice"`
+	Zip                 *string `xml:"Zip"`
+	Country             *string `xml:"Country"`
+	Phone               *string `xml:"Phone"`
+	PhoneExt            *string `xml:"PhoneExt"`
+	Fax                 *string `xml:"Fax"`
+	EmailAddress        *string `xml:"EmailAddress"`
+}
+
+// ContactInfo converts the address to a ContactInfo accepted by DomainsService.Create and DomainsService.SetContacts
+func (r UsersAddressGetInfoResult) ContactInfo() *ContactInfo {
+	return &ContactInfo{
+		FirstName:           r.FirstName,
+		LastName:            r.LastName,
+		Address1:            r.Address1,
+		Address2:            r.Address2,
+		City:                r.City,
+		StateProvince:       r.StateProvince,
+		StateProvinceChoice: r.StateProvinceChoice,
+		PostalCode:          r.Zip,
+		Country:             r.Country,
+		Phone:               r.Phone,
+		PhoneExt:            r.PhoneExt,
+		Fax:                 r.Fax,
+		EmailAddress:        r.EmailAddress,
+		OrganizationName:    r.Organization,
+		JobTitle:            r.JobTitle,
+	}
+}
+
+// GetInfo gets the details of an address
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users-address/get-info/
+func (s *UsersAddressService) GetInfo(addressID int) (*UsersAddressGetInfoCommandResponse, error) {
+	return s.GetInfoContext(context.Background(), addressID)
+}
+
+// GetInfoContext is like GetInfo but uses the provided context for the request and any retries
+func (s *UsersAddressService) GetInfoContext(ctx context.Context, addressID int) (*UsersAddressGetInfoCommandResponse, error) {
+	params := map[string]string{
+		"Command":   "namecheap.users.address.getInfo",
+		"AddressId": strconv.Itoa(addressID),
+	}
+
+	return doCommand[UsersAddressGetInfoCommandResponse](ctx, s.client, params)
+}
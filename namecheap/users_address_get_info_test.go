@@ -0,0 +1,102 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersAddressGetInfo(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.address.getInfo</RequestedCommand>
+			<CommandResponse Type="namecheap.users.address.getInfo">
+				<GetAddressInfoResult>
+					<AddressId>1504</AddressId>
+					<UserName>user</UserName>
+					<AddressName>Office</AddressName>
+					<Default_YN>true</Default_YN>
+					<FirstName>John</FirstName>
+					<LastName>Smith</LastName>
+					<JobTitle>Developer</JobTitle>
+					<Organization>NameCheap.com</Organization>
+					<Address1>8939 S. cross Blvd</Address1>
+					<Address2 />
+					<City>california</City>
+					<StateProvince>ca</StateProvince>
+					<StateProvinceChoice>S</StateProvinceChoice>
+					<Zip>90045</Zip>
+					<Country>US</Country>
+					<Phone>+1.6613102107</Phone>
+					<PhoneExt />
+					<Fax />
+					<EmailAddress>john@gmail.com</EmailAddress>
+				</GetAddressInfoResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.UsersAddress.GetInfo(1504)
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.address.getInfo", err)
+		}
+
+		assert.Equal(t, "namecheap.users.address.getInfo", sentBody.Get("Command"))
+		assert.Equal(t, "1504", sentBody.Get("AddressId"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.UsersAddress.GetInfo(1504)
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.address.getInfo", err)
+		}
+
+		result := response.GetAddressInfoResult
+		assert.Equal(t, 1504, *result.AddressID)
+		assert.Equal(t, "user", *result.UserName)
+		assert.Equal(t, "Office", *result.AddressName)
+		assert.True(t, *result.Default)
+
+		contact := result.ContactInfo()
+		assert.Equal(t, "John", *contact.FirstName)
+		assert.Equal(t, "Smith", *contact.LastName)
+		assert.Equal(t, "Developer", *contact.JobTitle)
+		assert.Equal(t, "NameCheap.com", *contact.OrganizationName)
+		assert.Equal(t, "90045", *contact.PostalCode)
+		assert.Equal(t, "S", *contact.StateProvinceChoice)
+		assert.Equal(t, "", *contact.Address2)
+		assert.Equal(t, "john@gmail.com", *contact.EmailAddress)
+	})
+}
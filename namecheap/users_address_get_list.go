@@ -0,0 +1,46 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+type UsersAddressGetListResponse = Response[UsersAddressGetListCommandResponse]
+
+type UsersAddressGetListCommandResponse struct {
+	Addresses *[]UserAddress `xml:"AddressGetListResult>List"`
+}
+
+type UserAddress struct {
+	AddressID   *int    `xml:"AddressId,attr"`
+	AddressName *string `xml:"AddressName,attr"`
+	IsDefault   *bool   `xml:"IsDefault,attr"`
+}
+
+func (a UserAddress) String() string {
+	addressID := 0
+	if a.AddressID != nil {
+		addressID = *a.AddressID
+	}
+	isDefault := false
+	if a.IsDefault != nil {
+		isDefault = *a.IsDefault
+	}
+	return fmt.Sprintf("{AddressID: %d, AddressName: %s, IsDefault: %t}", addressID, stringValue(a.AddressName), isDefault)
+}
+
+// GetList gets the list of addresses of the user
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users-address/get-list/
+func (s *UsersAddressService) GetList() (*UsersAddressGetListCommandResponse, error) {
+	return s.GetListContext(context.Background())
+}
+
+// GetListContext is like GetList but uses the provided context for the request and any retries
+func (s *UsersAddressService) GetListContext(ctx context.Context) (*UsersAddressGetListCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.users.address.getList",
+	}
+
+	return doCommand[UsersAddressGetListCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,73 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersAddressGetList(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.address.getList</RequestedCommand>
+			<CommandResponse Type="namecheap.users.address.getList">
+				<AddressGetListResult>
+					<List AddressId="0" AddressName="Primary Address" IsDefault="false" />
+					<List AddressId="1504" AddressName="Office" IsDefault="true" />
+				</AddressGetListResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.UsersAddress.GetList()
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.address.getList", err)
+		}
+
+		assert.Equal(t, "namecheap.users.address.getList", sentBody.Get("Command"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.UsersAddress.GetList()
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.address.getList", err)
+		}
+
+		assert.Equal(t, []UserAddress{
+			{AddressID: Int(0), AddressName: String("Primary Address"), IsDefault: Bool(false)},
+			{AddressID: Int(1504), AddressName: String("Office"), IsDefault: Bool(true)},
+		}, *response.Addresses)
+	})
+}
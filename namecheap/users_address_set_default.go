@@ -0,0 +1,34 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type UsersAddressSetDefaultResponse = Response[UsersAddressSetDefaultCommandResponse]
+
+type UsersAddressSetDefaultCommandResponse struct {
+	AddressSetDefaultResult *UsersAddressSetDefaultResult `xml:"AddressSetDefaultResult"`
+}
+
+type UsersAddressSetDefaultResult struct {
+	Success   *bool `xml:"Success,attr"`
+	AddressID *int  `xml:"AddressId,attr"`
+}
+
+// SetDefault sets the default address of the user
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users-address/set-default/
+func (s *UsersAddressService) SetDefault(addressID int) (*UsersAddressSetDefaultCommandResponse, error) {
+	return s.SetDefaultContext(context.Background(), addressID)
+}
+
+// SetDefaultContext is like SetDefault but uses the provided context for the request and any retries
+func (s *UsersAddressService) SetDefaultContext(ctx context.Context, addressID int) (*UsersAddressSetDefaultCommandResponse, error) {
+	params := map[string]string{
+		"Command":   "namecheap.users.address.setDefault",
+		"AddressId": strconv.Itoa(addressID),
+	}
+
+	return doCommand[UsersAddressSetDefaultCommandResponse](ctx, s.client, params)
+}
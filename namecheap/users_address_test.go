@@ -0,0 +1,174 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersAddressExpansion(t *testing.T) {
+	fakeGetInfoResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.address.getInfo</RequestedCommand>
+			<CommandResponse Type="namecheap.users.address.getInfo">
+				<GetAddressInfoResult>
+					<AddressId>1504</AddressId>
+					<AddressName>Office</AddressName>
+					<FirstName>John</FirstName>
+					<LastName>Smith</LastName>
+					<Organization>NameCheap.com</Organization>
+					<Address1>8939 S. cross Blvd</Address1>
+					<City>california</City>
+					<StateProvince>ca</StateProvince>
+					<Zip>90045</Zip>
+					<Country>US</Country>
+					<Phone>+1.6613102107</Phone>
+					<EmailAddress>john@gmail.com</EmailAddress>
+				</GetAddressInfoResult>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+	fakeCreateResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.domains.create</RequestedCommand>
+			<CommandResponse Type="namecheap.domains.create">
+				<DomainCreateResult Domain="domain1.com" Registered="true" ChargedAmount="20.3600" DomainID="9007" OrderID="196074" TransactionID="380716" WhoisguardEnable="false" NonRealTimeDomain="false" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+	fakeSetContactsResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.domains.setContacts</RequestedCommand>
+			<CommandResponse Type="namecheap.domains.setContacts">
+				<DomainSetContactResult Domain="domain1.com" IsSuccess="true" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	var sentBodies []url.Values
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		query, _ := url.ParseQuery(string(body))
+		sentBodies = append(sentBodies, query)
+
+		switch query.Get("Command") {
+		case "namecheap.users.address.getInfo":
+			_, _ = writer.Write([]byte(fakeGetInfoResponse))
+		case "namecheap.domains.create":
+			_, _ = writer.Write([]byte(fakeCreateResponse))
+		case "namecheap.domains.setContacts":
+			_, _ = writer.Write([]byte(fakeSetContactsResponse))
+		}
+	}))
+	defer mockServer.Close()
+
+	client := setupClient(nil)
+	client.BaseURL = mockServer.URL
+
+	t.Run("domains_create_with_address_id", func(t *testing.T) {
+		sentBodies = nil
+
+		args := &CreateArgs{
+			DomainName: String("domain1.com"),
+			Years:      Int(1),
+			AddressID:  Int(1504),
+		}
+
+		_, err := client.Domains.Create(args)
+		if err != nil {
+			t.Fatal("Unable to create domain", err)
+		}
+
+		assert.Len(t, sentBodies, 2)
+		assert.Equal(t, "1504", sentBodies[0].Get("AddressId"))
+
+		sentBody := sentBodies[1]
+		for _, prefix := range []string{"Registrant", "Tech", "Admin", "AuxBilling"} {
+			assert.Equal(t, "John", sentBody.Get(prefix+"FirstName"))
+			assert.Equal(t, "90045", sentBody.Get(prefix+"PostalCode"))
+			assert.Equal(t, "NameCheap.com", sentBody.Get(prefix+"OrganizationName"))
+		}
+		assert.Nil(t, args.Registrant)
+	})
+
+	t.Run("set_contacts_with_address_id", func(t *testing.T) {
+		sentBodies = nil
+
+		_, err := client.Domains.SetContacts("domain1.com", &SetContactsArgs{
+			Registrant: &ContactInfo{
+				FirstName:     String("Jane"),
+				LastName:      String("Doe"),
+				Address1:      String("1 Main St"),
+				City:          String("Phoenix"),
+				StateProvince: String("AZ"),
+				PostalCode:    String("85001"),
+				Country:       String("US"),
+				Phone:         String("+1.6025550100"),
+				EmailAddress:  String("jane@gmail.com"),
+			},
+			AddressID: Int(1504),
+		})
+		if err != nil {
+			t.Fatal("Unable to set contacts", err)
+		}
+
+		assert.Len(t, sentBodies, 2)
+
+		sentBody := sentBodies[1]
+		assert.Equal(t, "Jane", sentBody.Get("RegistrantFirstName"))
+		assert.Equal(t, "John", sentBody.Get("TechFirstName"))
+		assert.Equal(t, "John", sentBody.Get("AdminFirstName"))
+		assert.Equal(t, "John", sentBody.Get("AuxBillingFirstName"))
+	})
+
+	t.Run("address_not_fetched_when_contacts_set", func(t *testing.T) {
+		sentBodies = nil
+		contact := &ContactInfo{
+			FirstName:     String("Jane"),
+			LastName:      String("Doe"),
+			Address1:      String("1 Main St"),
+			City:          String("Phoenix"),
+			StateProvince: String("AZ"),
+			PostalCode:    String("85001"),
+			Country:       String("US"),
+			Phone:         String("+1.6025550100"),
+			EmailAddress:  String("jane@gmail.com"),
+		}
+
+		_, err := client.Domains.SetContacts("domain1.com", &SetContactsArgs{
+			Registrant: contact,
+			Tech:       contact,
+			Admin:      contact,
+			AuxBilling: contact,
+			AddressID:  Int(1504),
+		})
+		if err != nil {
+			t.Fatal("Unable to set contacts", err)
+		}
+
+		assert.Len(t, sentBodies, 1)
+	})
+}
@@ -0,0 +1,44 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type UsersAddressUpdateResponse = Response[UsersAddressUpdateCommandResponse]
+
+type UsersAddressUpdateCommandResponse struct {
+	AddressUpdateResult *UsersAddressUpdateResult `xml:"AddressUpdateResult"`
+}
+
+type UsersAddressUpdateResult struct {
+	Success     *bool   `xml:"Success,attr"`
+	AddressID   *int    `xml:"AddressId,attr"`
+	AddressName *string `xml:"AddressName,attr"`
+}
+
+// Update updates an address of the user
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users-address/update/
+func (s *UsersAddressService) Update(addressID int, args *UsersAddressArgs) (*UsersAddressUpdateCommandResponse, error) {
+	return s.UpdateContext(context.Background(), addressID, args)
+}
+
+// UpdateContext is like Update but uses the provided context for the request and any retries
+func (s *UsersAddressService) UpdateContext(ctx context.Context, addressID int, args *UsersAddressArgs) (*UsersAddressUpdateCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.users.address.update",
+	}
+
+	parsedArgs, err := parseUsersAddressArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	params["AddressId"] = strconv.Itoa(addressID)
+
+	return doCommand[UsersAddressUpdateCommandResponse](ctx, s.client, params)
+}
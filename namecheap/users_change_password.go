@@ -0,0 +1,84 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+// ChangePasswordArgs holds either the current password of the user or a code sent by ResetPassword
+type ChangePasswordArgs struct {
+	OldPassword *string
+	ResetCode   *string
+	NewPassword *string
+}
+
+type ChangePasswordResponse = Response[ChangePasswordCommandResponse]
+
+type ChangePasswordCommandResponse struct {
+	UserChangePasswordResult *ChangePasswordResult `xml:"UserChangePasswordResult"`
+}
+
+type ChangePasswordResult struct {
+	Success *bool `xml:"Success,attr"`
+	UserID  *int  `xml:"UserId,attr"`
+}
+
+func validateChangePasswordArgs(args *ChangePasswordArgs) error {
+	if args == nil {
+		return fmt.Errorf("ChangePasswordArgs is required")
+	}
+	if args.NewPassword == nil || *args.NewPassword == "" {
+		return fmt.Errorf("NewPassword is required")
+	}
+
+	hasOldPassword := args.OldPassword != nil && *args.OldPassword != ""
+	hasResetCode := args.ResetCode != nil && *args.ResetCode != ""
+	if hasOldPassword == hasResetCode {
+		return fmt.Errorf("exactly one of OldPassword or ResetCode is required")
+	}
+
+	return nil
+}
+
+func parseChangePasswordArgs(args *ChangePasswordArgs) (*map[string]string, error) {
+	err := validateChangePasswordArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"NewPassword": *args.NewPassword,
+	}
+
+	if args.OldPassword != nil && *args.OldPassword != "" {
+		params["OldPassword"] = *args.OldPassword
+	} else {
+		params["ResetCode"] = *args.ResetCode
+	}
+
+	return &params, nil
+}
+
+// ChangePassword changes the password of the user using either the current password or a reset code
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users/change-password/
+func (s *UsersService) ChangePassword(args *ChangePasswordArgs) (*ChangePasswordCommandResponse, error) {
+	return s.ChangePasswordContext(context.Background(), args)
+}
+
+// ChangePasswordContext is like ChangePassword but uses the provided context for the request and any retries
+func (s *UsersService) ChangePasswordContext(ctx context.Context, args *ChangePasswordArgs) (*ChangePasswordCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.users.changePassword",
+	}
+
+	parsedArgs, err := parseChangePasswordArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[ChangePasswordCommandResponse](ctx, s.client, params)
+}
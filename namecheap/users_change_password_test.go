@@ -0,0 +1,111 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersChangePassword(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.changePassword</RequestedCommand>
+			<CommandResponse Type="namecheap.users.changePassword">
+				<UserChangePasswordResult Success="true" UserId="1234" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Users.ChangePassword(&ChangePasswordArgs{OldPassword: String("old"), NewPassword: String("new")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.changePassword", err)
+		}
+
+		assert.Equal(t, "namecheap.users.changePassword", sentBody.Get("Command"))
+		assert.Equal(t, "old", sentBody.Get("OldPassword"))
+		assert.Equal(t, "new", sentBody.Get("NewPassword"))
+		assert.False(t, sentBody.Has("ResetCode"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Users.ChangePassword(&ChangePasswordArgs{OldPassword: String("old"), NewPassword: String("new")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.changePassword", err)
+		}
+
+		assert.True(t, *response.UserChangePasswordResult.Success)
+		assert.Equal(t, 1234, *response.UserChangePasswordResult.UserID)
+	})
+
+	t.Run("request_with_reset_code", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Users.ChangePassword(&ChangePasswordArgs{ResetCode: String("code"), NewPassword: String("new")})
+		if err != nil {
+			t.Fatal("Unable to change password", err)
+		}
+
+		assert.Equal(t, "code", sentBody.Get("ResetCode"))
+		assert.Equal(t, "new", sentBody.Get("NewPassword"))
+		assert.False(t, sentBody.Has("OldPassword"))
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Users.ChangePassword(nil)
+		assert.EqualError(t, err, "ChangePasswordArgs is required")
+
+		_, err = client.Users.ChangePassword(&ChangePasswordArgs{OldPassword: String("old")})
+		assert.EqualError(t, err, "NewPassword is required")
+
+		_, err = client.Users.ChangePassword(&ChangePasswordArgs{NewPassword: String("new")})
+		assert.EqualError(t, err, "exactly one of OldPassword or ResetCode is required")
+
+		_, err = client.Users.ChangePassword(&ChangePasswordArgs{OldPassword: String("old"), ResetCode: String("code"), NewPassword: String("new")})
+		assert.EqualError(t, err, "exactly one of OldPassword or ResetCode is required")
+	})
+}
@@ -0,0 +1,117 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+type UsersCreateArgs struct {
+	NewUserName     *string
+	NewUserPassword *string
+
+	Contact *ContactInfo
+
+	// IgnoreDuplicateEmailAddress allows creating an account with an email address already used by another one.
+	// Default value: true
+	IgnoreDuplicateEmailAddress *bool
+	// AcceptTerms must be set, the account is created only when the terms and conditions are accepted
+	AcceptTerms *bool
+	// AcceptNews subscribes the user to the newsletter
+	AcceptNews *bool
+}
+
+type UsersCreateResponse = Response[UsersCreateCommandResponse]
+
+type UsersCreateCommandResponse struct {
+	UserCreateResult *UsersCreateResult `xml:"UserCreateResult"`
+}
+
+type UsersCreateResult struct {
+	Success *bool `xml:"Success,attr"`
+	UserID  *int  `xml:"UserId,attr"`
+}
+
+func (r UsersCreateResult) String() string {
+	success := false
+	if r.Success != nil {
+		success = *r.Success
+	}
+	userID := 0
+	if r.UserID != nil {
+		userID = *r.UserID
+	}
+	return fmt.Sprintf("{Success: %t, UserID: %d}", success, userID)
+}
+
+func validateUsersCreateArgs(args *UsersCreateArgs) error {
+	if args == nil {
+		return fmt.Errorf("UsersCreateArgs is required")
+	}
+	if args.NewUserName == nil || *args.NewUserName == "" {
+		return fmt.Errorf("NewUserName is required")
+	}
+	if args.NewUserPassword == nil || *args.NewUserPassword == "" {
+		return fmt.Errorf("NewUserPassword is required")
+	}
+	if args.AcceptTerms == nil || !*args.AcceptTerms {
+		return fmt.Errorf("AcceptTerms must be true")
+	}
+	return validateUserContactInfo(args.Contact)
+}
+
+func parseUsersCreateArgs(args *UsersCreateArgs) (*map[string]string, error) {
+	params := map[string]string{}
+
+	err := validateUsersCreateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	addUserContactToParams(params, args.Contact)
+
+	params["NewUserName"] = *args.NewUserName
+	params["NewUserPassword"] = *args.NewUserPassword
+	params["AcceptTerms"] = "1"
+
+	if args.IgnoreDuplicateEmailAddress != nil {
+		if *args.IgnoreDuplicateEmailAddress {
+			params["IgnoreDuplicateEmailAddress"] = "yes"
+		} else {
+			params["IgnoreDuplicateEmailAddress"] = "no"
+		}
+	}
+
+	if args.AcceptNews != nil {
+		if *args.AcceptNews {
+			params["AcceptNews"] = "1"
+		} else {
+			params["AcceptNews"] = "0"
+		}
+	}
+
+	return &params, nil
+}
+
+// Create creates a new user account
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users/create/
+func (s *UsersService) Create(args *UsersCreateArgs) (*UsersCreateCommandResponse, error) {
+	return s.CreateContext(context.Background(), args)
+}
+
+// CreateContext is like Create but uses the provided context for the request and any retries
+func (s *UsersService) CreateContext(ctx context.Context, args *UsersCreateArgs) (*UsersCreateCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.users.create",
+	}
+
+	parsedArgs, err := parseUsersCreateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[UsersCreateCommandResponse](ctx, s.client, params)
+}
@@ -1,19 +1,12 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 	"strconv"
 )
 
-type CreateAddFundsRequestResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *CreateAddFundsRequestCommandResponse `xml:"CommandResponse"`
-}
+type CreateAddFundsRequestResponse = Response[CreateAddFundsRequestCommandResponse]
 
 type CreateAddFundsRequestCommandResponse struct {
 	CreateAddFundsRequestResult *CreateAddFundsRequestResult `xml:"Createaddfundsrequestresult"`
@@ -79,8 +72,11 @@ func parseCreateAddFundsRequestArgs(args *CreateAddFundsRequestArgs) (*map[strin
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/users/create-add-funds-request/
 func (s *UsersService) CreateAddFundsRequest(args *CreateAddFundsRequestArgs) (*CreateAddFundsRequestCommandResponse, error) {
-	var response CreateAddFundsRequestResponse
+	return s.CreateAddFundsRequestContext(context.Background(), args)
+}
 
+// CreateAddFundsRequestContext is like CreateAddFundsRequest but uses the provided context for the request and any retries
+func (s *UsersService) CreateAddFundsRequestContext(ctx context.Context, args *CreateAddFundsRequestArgs) (*CreateAddFundsRequestCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.users.createaddfundsrequest",
 	}
@@ -93,15 +89,5 @@ func (s *UsersService) CreateAddFundsRequest(args *CreateAddFundsRequestArgs) (*
 		params[k] = v
 	}
 
-	_, err = s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[CreateAddFundsRequestCommandResponse](ctx, s.client, params)
 }
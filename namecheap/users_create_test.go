@@ -0,0 +1,99 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersCreate(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.create</RequestedCommand>
+			<CommandResponse Type="namecheap.users.create">
+				<UserCreateResult Success="true" UserId="1235" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Users.Create(&UsersCreateArgs{NewUserName: String("newuser"), NewUserPassword: String("secret"), Contact: &ContactInfo{FirstName: String("John"), LastName: String("Smith"), Address1: String("8939 S. cross Blvd"), City: String("california"), StateProvince: String("ca"), PostalCode: String("90045"), Country: String("US"), Phone: String("+1.6613102107"), EmailAddress: String("john@gmail.com"), OrganizationName: String("NameCheap.com")}, IgnoreDuplicateEmailAddress: Bool(false), AcceptTerms: Bool(true), AcceptNews: Bool(false)})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.create", err)
+		}
+
+		assert.Equal(t, "namecheap.users.create", sentBody.Get("Command"))
+		assert.Equal(t, "newuser", sentBody.Get("NewUserName"))
+		assert.Equal(t, "secret", sentBody.Get("NewUserPassword"))
+		assert.Equal(t, "no", sentBody.Get("IgnoreDuplicateEmailAddress"))
+		assert.Equal(t, "1", sentBody.Get("AcceptTerms"))
+		assert.Equal(t, "0", sentBody.Get("AcceptNews"))
+		assert.Equal(t, "John", sentBody.Get("FirstName"))
+		assert.Equal(t, "Smith", sentBody.Get("LastName"))
+		assert.Equal(t, "90045", sentBody.Get("Zip"))
+		assert.Equal(t, "NameCheap.com", sentBody.Get("Organization"))
+		assert.Equal(t, "john@gmail.com", sentBody.Get("EmailAddress"))
+		assert.False(t, sentBody.Has("PostalCode"))
+		assert.False(t, sentBody.Has("OrganizationName"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Users.Create(&UsersCreateArgs{NewUserName: String("newuser"), NewUserPassword: String("secret"), Contact: &ContactInfo{FirstName: String("John"), LastName: String("Smith"), Address1: String("8939 S. cross Blvd"), City: String("california"), StateProvince: String("ca"), PostalCode: String("90045"), Country: String("US"), Phone: String("+1.6613102107"), EmailAddress: String("john@gmail.com"), OrganizationName: String("NameCheap.com")}, IgnoreDuplicateEmailAddress: Bool(false), AcceptTerms: Bool(true), AcceptNews: Bool(false)})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.create", err)
+		}
+
+		assert.True(t, *response.UserCreateResult.Success)
+		assert.Equal(t, 1235, *response.UserCreateResult.UserID)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Users.Create(nil)
+		assert.EqualError(t, err, "UsersCreateArgs is required")
+
+		_, err = client.Users.Create(&UsersCreateArgs{NewUserPassword: String("secret")})
+		assert.EqualError(t, err, "NewUserName is required")
+
+		_, err = client.Users.Create(&UsersCreateArgs{NewUserName: String("newuser")})
+		assert.EqualError(t, err, "NewUserPassword is required")
+
+		_, err = client.Users.Create(&UsersCreateArgs{NewUserName: String("newuser"), NewUserPassword: String("secret")})
+		assert.EqualError(t, err, "AcceptTerms must be true")
+
+		_, err = client.Users.Create(&UsersCreateArgs{NewUserName: String("newuser"), NewUserPassword: String("secret"), AcceptTerms: Bool(true)})
+		assert.EqualError(t, err, "contact information is required")
+	})
+}
@@ -1,18 +1,11 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
-type GetAddFundsStatusResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *GetAddFundsStatusCommandResponse `xml:"CommandResponse"`
-}
+type GetAddFundsStatusResponse = Response[GetAddFundsStatusCommandResponse]
 
 type GetAddFundsStatusCommandResponse struct {
 	GetAddFundsStatusResult *GetAddFundsStatusResult `xml:"GetAddFundsStatusResult"`
@@ -32,22 +25,15 @@ func (r GetAddFundsStatusResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/users/get-add-funds-status/
 func (s *UsersService) GetAddFundsStatus(tokenID string) (*GetAddFundsStatusCommandResponse, error) {
-	var response GetAddFundsStatusResponse
+	return s.GetAddFundsStatusContext(context.Background(), tokenID)
+}
 
+// GetAddFundsStatusContext is like GetAddFundsStatus but uses the provided context for the request and any retries
+func (s *UsersService) GetAddFundsStatusContext(ctx context.Context, tokenID string) (*GetAddFundsStatusCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.users.getAddFundsStatus",
 		"TokenID": tokenID,
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[GetAddFundsStatusCommandResponse](ctx, s.client, params)
 }
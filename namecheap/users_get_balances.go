@@ -1,18 +1,11 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
-type GetBalancesResponse struct {
-	XMLName         *xml.Name `xml:"ApiResponse"`
-	Errors          *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *GetBalancesCommandResponse `xml:"CommandResponse"`
-}
+type GetBalancesResponse = Response[GetBalancesCommandResponse]
 
 type GetBalancesCommandResponse struct {
 	UserGetBalancesResult *GetBalancesResult `xml:"UserGetBalancesResult"`
@@ -36,21 +29,14 @@ func (r GetBalancesResult) String() string {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/users/get-balances/
 func (s *UsersService) GetBalances() (*GetBalancesCommandResponse, error) {
-	var response GetBalancesResponse
+	return s.GetBalancesContext(context.Background())
+}
 
+// GetBalancesContext is like GetBalances but uses the provided context for the request and any retries
+func (s *UsersService) GetBalancesContext(ctx context.Context) (*GetBalancesCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.users.getBalances",
 	}
 
-	_, err := s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[GetBalancesCommandResponse](ctx, s.client, params)
 }
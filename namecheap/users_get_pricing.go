@@ -1,7 +1,7 @@
 package namecheap
 
 import (
-	"encoding/xml"
+	"context"
 	"fmt"
 )
 
@@ -44,14 +44,7 @@ type GetPricingArgs struct {
 	ProductName     *ProductName
 }
 
-type GetPricingResponse struct {
-	XMLName *xml.Name `xml:"ApiResponse"`
-	Errors  *[]struct {
-		Message *string `xml:",chardata"`
-		Number  *string `xml:"Number,attr"`
-	} `xml:"Errors>Error"`
-	CommandResponse *GetPricingCommandResponse `xml:"CommandResponse"`
-}
+type GetPricingResponse = Response[GetPricingCommandResponse]
 
 type GetPricingCommandResponse struct {
 	UserGetPricingResult *GetPricingResult `xml:"UserGetPricingResult"`
@@ -133,8 +126,11 @@ func parseGetPricingArgs(args *GetPricingArgs) (*map[string]string, error) {
 //
 // Namecheap doc: https://www.namecheap.com/support/api/methods/users/get-pricing/
 func (s *UsersService) GetPricing(args *GetPricingArgs) (*GetPricingCommandResponse, error) {
-	var response GetPricingResponse
+	return s.GetPricingContext(context.Background(), args)
+}
 
+// GetPricingContext is like GetPricing but uses the provided context for the request and any retries
+func (s *UsersService) GetPricingContext(ctx context.Context, args *GetPricingArgs) (*GetPricingCommandResponse, error) {
 	params := map[string]string{
 		"Command": "namecheap.users.getPricing",
 	}
@@ -147,15 +143,5 @@ func (s *UsersService) GetPricing(args *GetPricingArgs) (*GetPricingCommandRespo
 		params[k] = v
 	}
 
-	_, err = s.client.DoXML(params, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	if response.Errors != nil && len(*response.Errors) > 0 {
-		apiErr := (*response.Errors)[0]
-		return nil, fmt.Errorf("%s (%s)", *apiErr.Message, *apiErr.Number)
-	}
-
-	return response.CommandResponse, nil
+	return doCommand[GetPricingCommandResponse](ctx, s.client, params)
 }
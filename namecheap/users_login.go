@@ -0,0 +1,31 @@
+package namecheap
+
+import "context"
+
+type LoginResponse = Response[LoginCommandResponse]
+
+type LoginCommandResponse struct {
+	UserLoginResult *LoginResult `xml:"UserLoginResult"`
+}
+
+type LoginResult struct {
+	UserName     *string `xml:"Username,attr"`
+	LoginSuccess *bool   `xml:"LoginSuccess,attr"`
+}
+
+// Login validates the password of the user set in the ClientOptions
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users/login/
+func (s *UsersService) Login(password string) (*LoginCommandResponse, error) {
+	return s.LoginContext(context.Background(), password)
+}
+
+// LoginContext is like Login but uses the provided context for the request and any retries
+func (s *UsersService) LoginContext(ctx context.Context, password string) (*LoginCommandResponse, error) {
+	params := map[string]string{
+		"Command":  "namecheap.users.login",
+		"Password": password,
+	}
+
+	return doCommand[LoginCommandResponse](ctx, s.client, params)
+}
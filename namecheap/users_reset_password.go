@@ -0,0 +1,102 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+var allowedResetPasswordFindByValues = []string{"EMAILADDRESS", "DOMAINNAME", "USERNAME"}
+
+type ResetPasswordArgs struct {
+	// Possible values are EMAILADDRESS, DOMAINNAME, USERNAME
+	FindBy      *string
+	FindByValue *string
+	// EmailFromName and EmailFromAddress customize the sender of the reset email
+	EmailFromName    *string
+	EmailFromAddress *string
+	// URLPattern is the reset link sent to the user, it must contain the [RESETCODE] placeholder
+	URLPattern *string
+}
+
+type ResetPasswordResponse = Response[ResetPasswordCommandResponse]
+
+type ResetPasswordCommandResponse struct {
+	UserResetPasswordResult *ResetPasswordResult `xml:"UserResetPasswordResult"`
+}
+
+type ResetPasswordResult struct {
+	Success *bool `xml:"Success,attr"`
+}
+
+func validateResetPasswordArgs(args *ResetPasswordArgs) error {
+	if args == nil {
+		return fmt.Errorf("ResetPasswordArgs is required")
+	}
+	if args.FindBy == nil {
+		return fmt.Errorf("FindBy is required")
+	}
+	if !isValidResetPasswordFindBy(*args.FindBy) {
+		return fmt.Errorf("invalid FindBy value: %s", *args.FindBy)
+	}
+	if args.FindByValue == nil || *args.FindByValue == "" {
+		return fmt.Errorf("FindByValue is required")
+	}
+	return nil
+}
+
+func parseResetPasswordArgs(args *ResetPasswordArgs) (*map[string]string, error) {
+	err := validateResetPasswordArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"FindBy":      *args.FindBy,
+		"FindByValue": *args.FindByValue,
+	}
+
+	if args.EmailFromName != nil {
+		params["EmailFromName"] = *args.EmailFromName
+	}
+	if args.EmailFromAddress != nil {
+		params["EmailFromAddress"] = *args.EmailFromAddress
+	}
+	if args.URLPattern != nil {
+		params["URLPattern"] = *args.URLPattern
+	}
+
+	return &params, nil
+}
+
+func isValidResetPasswordFindBy(findBy string) bool {
+	for _, value := range allowedResetPasswordFindByValues {
+		if findBy == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ResetPassword sends a password reset link to the user, the code from the link is accepted by ChangePassword
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users/reset-password/
+func (s *UsersService) ResetPassword(args *ResetPasswordArgs) (*ResetPasswordCommandResponse, error) {
+	return s.ResetPasswordContext(context.Background(), args)
+}
+
+// ResetPasswordContext is like ResetPassword but uses the provided context for the request and any retries
+func (s *UsersService) ResetPasswordContext(ctx context.Context, args *ResetPasswordArgs) (*ResetPasswordCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.users.resetPassword",
+	}
+
+	parsedArgs, err := parseResetPasswordArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[ResetPasswordCommandResponse](ctx, s.client, params)
+}
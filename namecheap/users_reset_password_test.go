@@ -0,0 +1,88 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersResetPassword(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.resetPassword</RequestedCommand>
+			<CommandResponse Type="namecheap.users.resetPassword">
+				<UserResetPasswordResult Success="true" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Users.ResetPassword(&ResetPasswordArgs{FindBy: String("EMAILADDRESS"), FindByValue: String("john@gmail.com"), EmailFromName: String("Reseller"), EmailFromAddress: String("support@reseller.com"), URLPattern: String("https://reseller.com/reset?code=[RESETCODE]")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.resetPassword", err)
+		}
+
+		assert.Equal(t, "namecheap.users.resetPassword", sentBody.Get("Command"))
+		assert.Equal(t, "EMAILADDRESS", sentBody.Get("FindBy"))
+		assert.Equal(t, "john@gmail.com", sentBody.Get("FindByValue"))
+		assert.Equal(t, "Reseller", sentBody.Get("EmailFromName"))
+		assert.Equal(t, "support@reseller.com", sentBody.Get("EmailFromAddress"))
+		assert.Equal(t, "https://reseller.com/reset?code=[RESETCODE]", sentBody.Get("URLPattern"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Users.ResetPassword(&ResetPasswordArgs{FindBy: String("EMAILADDRESS"), FindByValue: String("john@gmail.com"), EmailFromName: String("Reseller"), EmailFromAddress: String("support@reseller.com"), URLPattern: String("https://reseller.com/reset?code=[RESETCODE]")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.resetPassword", err)
+		}
+
+		assert.True(t, *response.UserResetPasswordResult.Success)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Users.ResetPassword(nil)
+		assert.EqualError(t, err, "ResetPasswordArgs is required")
+
+		_, err = client.Users.ResetPassword(&ResetPasswordArgs{FindByValue: String("john@gmail.com")})
+		assert.EqualError(t, err, "FindBy is required")
+
+		_, err = client.Users.ResetPassword(&ResetPasswordArgs{FindBy: String("PHONE"), FindByValue: String("123")})
+		assert.EqualError(t, err, "invalid FindBy value: PHONE")
+
+		_, err = client.Users.ResetPassword(&ResetPasswordArgs{FindBy: String("USERNAME")})
+		assert.EqualError(t, err, "FindByValue is required")
+	})
+}
@@ -0,0 +1,75 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+type UsersUpdateResponse = Response[UsersUpdateCommandResponse]
+
+type UsersUpdateCommandResponse struct {
+	UserUpdateResult *UsersUpdateResult `xml:"UserUpdateResult"`
+}
+
+type UsersUpdateResult struct {
+	Success *bool `xml:"Success,attr"`
+	UserID  *int  `xml:"UserId,attr"`
+}
+
+func (r UsersUpdateResult) String() string {
+	success := false
+	if r.Success != nil {
+		success = *r.Success
+	}
+	userID := 0
+	if r.UserID != nil {
+		userID = *r.UserID
+	}
+	return fmt.Sprintf("{Success: %t, UserID: %d}", success, userID)
+}
+
+// validateUserContactInfo checks the contact fields required by the users commands
+func validateUserContactInfo(contact *ContactInfo) error {
+	if contact == nil {
+		return fmt.Errorf("contact information is required")
+	}
+	return validateContactInfo(contact, "")
+}
+
+// addUserContactToParams adds the contact to the params under the names used by the users commands,
+// which differ from the domains ones for the postal code and the organization
+func addUserContactToParams(params map[string]string, contact *ContactInfo) {
+	addContactToParams(params, contact, "")
+
+	if contact.PostalCode != nil {
+		delete(params, "PostalCode")
+		params["Zip"] = *contact.PostalCode
+	}
+	if contact.OrganizationName != nil {
+		delete(params, "OrganizationName")
+		params["Organization"] = *contact.OrganizationName
+	}
+	delete(params, "StateProvinceChoice")
+}
+
+// Update updates the contact information of the user
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/users/update/
+func (s *UsersService) Update(contact *ContactInfo) (*UsersUpdateCommandResponse, error) {
+	return s.UpdateContext(context.Background(), contact)
+}
+
+// UpdateContext is like Update but uses the provided context for the request and any retries
+func (s *UsersService) UpdateContext(ctx context.Context, contact *ContactInfo) (*UsersUpdateCommandResponse, error) {
+	params := map[string]string{}
+
+	err := validateUserContactInfo(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	addUserContactToParams(params, contact)
+	params["Command"] = "namecheap.users.update"
+
+	return doCommand[UsersUpdateCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,85 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersUpdate(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.users.update</RequestedCommand>
+			<CommandResponse Type="namecheap.users.update">
+				<UserUpdateResult Success="true" UserId="1234" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Users.Update(&ContactInfo{FirstName: String("John"), LastName: String("Smith"), Address1: String("8939 S. cross Blvd"), City: String("california"), StateProvince: String("ca"), PostalCode: String("90045"), Country: String("US"), Phone: String("+1.6613102107"), EmailAddress: String("john@gmail.com"), OrganizationName: String("NameCheap.com")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.update", err)
+		}
+
+		assert.Equal(t, "namecheap.users.update", sentBody.Get("Command"))
+		assert.Equal(t, "John", sentBody.Get("FirstName"))
+		assert.Equal(t, "Smith", sentBody.Get("LastName"))
+		assert.Equal(t, "90045", sentBody.Get("Zip"))
+		assert.Equal(t, "NameCheap.com", sentBody.Get("Organization"))
+		assert.Equal(t, "john@gmail.com", sentBody.Get("EmailAddress"))
+		assert.False(t, sentBody.Has("PostalCode"))
+		assert.False(t, sentBody.Has("OrganizationName"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Users.Update(&ContactInfo{FirstName: String("John"), LastName: String("Smith"), Address1: String("8939 S. cross Blvd"), City: String("california"), StateProvince: String("ca"), PostalCode: String("90045"), Country: String("US"), Phone: String("+1.6613102107"), EmailAddress: String("john@gmail.com"), OrganizationName: String("NameCheap.com")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.users.update", err)
+		}
+
+		assert.True(t, *response.UserUpdateResult.Success)
+		assert.Equal(t, 1234, *response.UserUpdateResult.UserID)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Users.Update(nil)
+		assert.EqualError(t, err, "contact information is required")
+
+		_, err = client.Users.Update(&ContactInfo{FirstName: String("John")})
+		assert.EqualError(t, err, "LastName is required")
+	})
+}
@@ -0,0 +1,73 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+)
+
+// WhoisguardService includes the following methods:
+// WhoisguardService.GetList - gets the list of WhoisGuard privacy protection subscriptions
+// WhoisguardService.Enable - enables WhoisGuard privacy protection
+// WhoisguardService.Disable - disables WhoisGuard privacy protection
+// WhoisguardService.Allot - allots WhoisGuard privacy protection to a domain
+// WhoisguardService.Unallot - unallots WhoisGuard privacy protection from a domain
+// WhoisguardService.Discard - discards a WhoisGuard subscription
+// WhoisguardService.Renew - renews WhoisGuard privacy protection
+// WhoisguardService.ChangeEmailAddress - changes the WhoisGuard email address
+//
+// EnableByDomain and DisableByDomain accept a domain name and resolve its WhoisGuard ID with GetIDByDomain.
+//
+// Every method has a ...Context variant taking a context.Context as its first argument.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/
+type WhoisguardService service
+
+// GetIDByDomain returns the ID of the WhoisGuard subscription allotted to the domain
+func (s *WhoisguardService) GetIDByDomain(domain string) (int, error) {
+	return s.GetIDByDomainContext(context.Background(), domain)
+}
+
+// GetIDByDomainContext is like GetIDByDomain but uses the provided context for the request and any retries
+func (s *WhoisguardService) GetIDByDomainContext(ctx context.Context, domain string) (int, error) {
+	info, err := (*DomainsService)(s).GetInfoContext(ctx, domain)
+	if err != nil {
+		return 0, err
+	}
+
+	result := info.DomainDNSGetListResult
+	if result == nil || result.Whoisguard == nil || result.Whoisguard.ID == nil || *result.Whoisguard.ID <= 0 {
+		return 0, fmt.Errorf("no WhoisGuard subscription is allotted to %s", domain)
+	}
+
+	return *result.Whoisguard.ID, nil
+}
+
+// EnableByDomain enables WhoisGuard privacy protection of the domain
+func (s *WhoisguardService) EnableByDomain(domain string, forwardedToEmail string) (*WhoisguardEnableCommandResponse, error) {
+	return s.EnableByDomainContext(context.Background(), domain, forwardedToEmail)
+}
+
+// EnableByDomainContext is like EnableByDomain but uses the provided context for the requests and any retries
+func (s *WhoisguardService) EnableByDomainContext(ctx context.Context, domain string, forwardedToEmail string) (*WhoisguardEnableCommandResponse, error) {
+	id, err := s.GetIDByDomainContext(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.EnableContext(ctx, id, forwardedToEmail)
+}
+
+// DisableByDomain disables WhoisGuard privacy protection of the domain
+func (s *WhoisguardService) DisableByDomain(domain string) (*WhoisguardDisableCommandResponse, error) {
+	return s.DisableByDomainContext(context.Background(), domain)
+}
+
+// DisableByDomainContext is like DisableByDomain but uses the provided context for the requests and any retries
+func (s *WhoisguardService) DisableByDomainContext(ctx context.Context, domain string) (*WhoisguardDisableCommandResponse, error) {
+	id, err := s.GetIDByDomainContext(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.DisableContext(ctx, id)
+}
@@ -0,0 +1,87 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type WhoisguardAllotArgs struct {
+	WhoisguardID *int
+	DomainName   *string
+	// ForwardedToEmail is the email address the WhoisGuard emails are forwarded to
+	ForwardedToEmail *string
+	// EnableWG enables WhoisGuard privacy protection right after it is allotted
+	EnableWG *bool
+}
+
+type WhoisguardAllotResponse = Response[WhoisguardAllotCommandResponse]
+
+type WhoisguardAllotCommandResponse struct {
+	WhoisguardAllotResult *WhoisguardAllotResult `xml:"WhoisguardAllotResult"`
+}
+
+type WhoisguardAllotResult struct {
+	WhoisguardID *int    `xml:"WhoisguardId,attr"`
+	DomainName   *string `xml:"DomainName,attr"`
+	IsSuccess    *bool   `xml:"IsSuccess,attr"`
+}
+
+func validateWhoisguardAllotArgs(args *WhoisguardAllotArgs) error {
+	if args == nil {
+		return fmt.Errorf("WhoisguardAllotArgs is required")
+	}
+	if args.WhoisguardID == nil {
+		return fmt.Errorf("WhoisguardID is required")
+	}
+	if args.DomainName == nil || *args.DomainName == "" {
+		return fmt.Errorf("DomainName is required")
+	}
+	return nil
+}
+
+func parseWhoisguardAllotArgs(args *WhoisguardAllotArgs) (*map[string]string, error) {
+	err := validateWhoisguardAllotArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"WhoisguardID": strconv.Itoa(*args.WhoisguardID),
+		"DomainName":   *args.DomainName,
+	}
+
+	if args.ForwardedToEmail != nil {
+		params["ForwardedToEmail"] = *args.ForwardedToEmail
+	}
+
+	if args.EnableWG != nil {
+		params["EnableWG"] = strconv.FormatBool(*args.EnableWG)
+	}
+
+	return &params, nil
+}
+
+// Allot allots WhoisGuard privacy protection to a domain
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/allot/
+func (s *WhoisguardService) Allot(args *WhoisguardAllotArgs) (*WhoisguardAllotCommandResponse, error) {
+	return s.AllotContext(context.Background(), args)
+}
+
+// AllotContext is like Allot but uses the provided context for the request and any retries
+func (s *WhoisguardService) AllotContext(ctx context.Context, args *WhoisguardAllotArgs) (*WhoisguardAllotCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.whoisguard.allot",
+	}
+
+	parsedArgs, err := parseWhoisguardAllotArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[WhoisguardAllotCommandResponse](ctx, s.client, params)
+}
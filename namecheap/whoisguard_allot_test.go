@@ -0,0 +1,87 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhoisguardAllot(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.whoisguard.allot</RequestedCommand>
+			<CommandResponse Type="namecheap.whoisguard.allot">
+				<WhoisguardAllotResult WhoisguardId="53536" DomainName="domain1.com" IsSuccess="true" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Whoisguard.Allot(&WhoisguardAllotArgs{WhoisguardID: Int(53536), DomainName: String("domain1.com"), ForwardedToEmail: String("john@example.com"), EnableWG: Bool(true)})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.whoisguard.allot", err)
+		}
+
+		assert.Equal(t, "namecheap.whoisguard.allot", sentBody.Get("Command"))
+		assert.Equal(t, "53536", sentBody.Get("WhoisguardID"))
+		assert.Equal(t, "domain1.com", sentBody.Get("DomainName"))
+		assert.Equal(t, "john@example.com", sentBody.Get("ForwardedToEmail"))
+		assert.Equal(t, "true", sentBody.Get("EnableWG"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Whoisguard.Allot(&WhoisguardAllotArgs{WhoisguardID: Int(53536), DomainName: String("domain1.com"), ForwardedToEmail: String("john@example.com"), EnableWG: Bool(true)})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.whoisguard.allot", err)
+		}
+
+		result := response.WhoisguardAllotResult
+		assert.Equal(t, 53536, *result.WhoisguardID)
+		assert.Equal(t, "domain1.com", *result.DomainName)
+		assert.True(t, *result.IsSuccess)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Whoisguard.Allot(nil)
+		assert.EqualError(t, err, "WhoisguardAllotArgs is required")
+
+		_, err = client.Whoisguard.Allot(&WhoisguardAllotArgs{DomainName: String("domain1.com")})
+		assert.EqualError(t, err, "WhoisguardID is required")
+
+		_, err = client.Whoisguard.Allot(&WhoisguardAllotArgs{WhoisguardID: Int(53536)})
+		assert.EqualError(t, err, "DomainName is required")
+	})
+}
@@ -0,0 +1,36 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type WhoisguardChangeEmailAddressResponse = Response[WhoisguardChangeEmailAddressCommandResponse]
+
+type WhoisguardChangeEmailAddressCommandResponse struct {
+	WhoisguardChangeEmailAddressResult *WhoisguardChangeEmailAddressResult `xml:"WhoisguardChangeEmailAddressResult"`
+}
+
+type WhoisguardChangeEmailAddressResult struct {
+	ID         *int    `xml:"ID,attr"`
+	IsSuccess  *bool   `xml:"IsSuccess,attr"`
+	WGEmail    *string `xml:"WGEmail,attr"`
+	WGOldEmail *string `xml:"WGOldEmail,attr"`
+}
+
+// ChangeEmailAddress replaces the WhoisGuard email address shown in the WHOIS record with a new random one
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/changeemailaddress/
+func (s *WhoisguardService) ChangeEmailAddress(whoisguardID int) (*WhoisguardChangeEmailAddressCommandResponse, error) {
+	return s.ChangeEmailAddressContext(context.Background(), whoisguardID)
+}
+
+// ChangeEmailAddressContext is like ChangeEmailAddress but uses the provided context for the request and any retries
+func (s *WhoisguardService) ChangeEmailAddressContext(ctx context.Context, whoisguardID int) (*WhoisguardChangeEmailAddressCommandResponse, error) {
+	params := map[string]string{
+		"Command":      "namecheap.whoisguard.changeemailaddress",
+		"WhoisguardID": strconv.Itoa(whoisguardID),
+	}
+
+	return doCommand[WhoisguardChangeEmailAddressCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,72 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhoisguardChangeEmailAddress(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.whoisguard.changeemailaddress</RequestedCommand>
+			<CommandResponse Type="namecheap.whoisguard.changeemailaddress">
+				<WhoisguardChangeEmailAddressResult ID="53536" IsSuccess="true" WGEmail="new@whoisguard.com" WGOldEmail="old@whoisguard.com" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Whoisguard.ChangeEmailAddress(53536)
+		if err != nil {
+			t.Fatal("Unable to call namecheap.whoisguard.changeemailaddress", err)
+		}
+
+		assert.Equal(t, "namecheap.whoisguard.changeemailaddress", sentBody.Get("Command"))
+		assert.Equal(t, "53536", sentBody.Get("WhoisguardID"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Whoisguard.ChangeEmailAddress(53536)
+		if err != nil {
+			t.Fatal("Unable to call namecheap.whoisguard.changeemailaddress", err)
+		}
+
+		result := response.WhoisguardChangeEmailAddressResult
+		assert.Equal(t, 53536, *result.ID)
+		assert.True(t, *result.IsSuccess)
+		assert.Equal(t, "new@whoisguard.com", *result.WGEmail)
+		assert.Equal(t, "old@whoisguard.com", *result.WGOldEmail)
+	})
+}
@@ -0,0 +1,34 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type WhoisguardDisableResponse = Response[WhoisguardDisableCommandResponse]
+
+type WhoisguardDisableCommandResponse struct {
+	WhoisguardDisableResult *WhoisguardDisableResult `xml:"WhoisguardDisableResult"`
+}
+
+type WhoisguardDisableResult struct {
+	DomainName *string `xml:"DomainName,attr"`
+	IsSuccess  *bool   `xml:"IsSuccess,attr"`
+}
+
+// Disable disables WhoisGuard privacy protection
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/disable/
+func (s *WhoisguardService) Disable(whoisguardID int) (*WhoisguardDisableCommandResponse, error) {
+	return s.DisableContext(context.Background(), whoisguardID)
+}
+
+// DisableContext is like Disable but uses the provided context for the request and any retries
+func (s *WhoisguardService) DisableContext(ctx context.Context, whoisguardID int) (*WhoisguardDisableCommandResponse, error) {
+	params := map[string]string{
+		"Command":      "namecheap.whoisguard.disable",
+		"WhoisguardID": strconv.Itoa(whoisguardID),
+	}
+
+	return doCommand[WhoisguardDisableCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,34 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type WhoisguardDiscardResponse = Response[WhoisguardDiscardCommandResponse]
+
+type WhoisguardDiscardCommandResponse struct {
+	WhoisguardDiscardResult *WhoisguardDiscardResult `xml:"WhoisguardDiscardResult"`
+}
+
+type WhoisguardDiscardResult struct {
+	WhoisguardID *int  `xml:"WhoisguardId,attr"`
+	IsSuccess    *bool `xml:"IsSuccess,attr"`
+}
+
+// Discard discards a WhoisGuard subscription
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/discard/
+func (s *WhoisguardService) Discard(whoisguardID int) (*WhoisguardDiscardCommandResponse, error) {
+	return s.DiscardContext(context.Background(), whoisguardID)
+}
+
+// DiscardContext is like Discard but uses the provided context for the request and any retries
+func (s *WhoisguardService) DiscardContext(ctx context.Context, whoisguardID int) (*WhoisguardDiscardCommandResponse, error) {
+	params := map[string]string{
+		"Command":      "namecheap.whoisguard.discard",
+		"WhoisguardID": strconv.Itoa(whoisguardID),
+	}
+
+	return doCommand[WhoisguardDiscardCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,35 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type WhoisguardEnableResponse = Response[WhoisguardEnableCommandResponse]
+
+type WhoisguardEnableCommandResponse struct {
+	WhoisguardEnableResult *WhoisguardEnableResult `xml:"WhoisguardEnableResult"`
+}
+
+type WhoisguardEnableResult struct {
+	DomainName *string `xml:"DomainName,attr"`
+	IsSuccess  *bool   `xml:"IsSuccess,attr"`
+}
+
+// Enable enables WhoisGuard privacy protection, the emails sent to the WhoisGuard address are forwarded to forwardedToEmail
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/enable/
+func (s *WhoisguardService) Enable(whoisguardID int, forwardedToEmail string) (*WhoisguardEnableCommandResponse, error) {
+	return s.EnableContext(context.Background(), whoisguardID, forwardedToEmail)
+}
+
+// EnableContext is like Enable but uses the provided context for the request and any retries
+func (s *WhoisguardService) EnableContext(ctx context.Context, whoisguardID int, forwardedToEmail string) (*WhoisguardEnableCommandResponse, error) {
+	params := map[string]string{
+		"Command":          "namecheap.whoisguard.enable",
+		"WhoisguardID":     strconv.Itoa(whoisguardID),
+		"ForwardedToEmail": forwardedToEmail,
+	}
+
+	return doCommand[WhoisguardEnableCommandResponse](ctx, s.client, params)
+}
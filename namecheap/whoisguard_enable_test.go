@@ -0,0 +1,70 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhoisguardEnable(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.whoisguard.enable</RequestedCommand>
+			<CommandResponse Type="namecheap.whoisguard.enable">
+				<WhoisguardEnableResult DomainName="domain1.com" IsSuccess="true" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Whoisguard.Enable(53536, "john@example.com")
+		if err != nil {
+			t.Fatal("Unable to call namecheap.whoisguard.enable", err)
+		}
+
+		assert.Equal(t, "namecheap.whoisguard.enable", sentBody.Get("Command"))
+		assert.Equal(t, "53536", sentBody.Get("WhoisguardID"))
+		assert.Equal(t, "john@example.com", sentBody.Get("ForwardedToEmail"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Whoisguard.Enable(53536, "john@example.com")
+		if err != nil {
+			t.Fatal("Unable to call namecheap.whoisguard.enable", err)
+		}
+
+		assert.Equal(t, "domain1.com", *response.WhoisguardEnableResult.DomainName)
+		assert.True(t, *response.WhoisguardEnableResult.IsSuccess)
+	})
+}
@@ -0,0 +1,113 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+var allowedWhoisguardListTypeValues = []string{"ALL", "ALLOTED", "FREE", "DISCARD"}
+
+type WhoisguardGetListResponse = Response[WhoisguardGetListCommandResponse]
+
+type WhoisguardGetListCommandResponse struct {
+	Whoisguards *[]Whoisguard         `xml:"WhoisguardGetListResult>Whoisguard"`
+	Paging      *DomainsGetListPaging `xml:"Paging"`
+}
+
+type Whoisguard struct {
+	ID         *int      `xml:"ID,attr"`
+	DomainName *string   `xml:"DomainName,attr"`
+	Created    *DateTime `xml:"Created,attr"`
+	Expires    *DateTime `xml:"Expires,attr"`
+	Status     *string   `xml:"Status,attr"`
+}
+
+func (w Whoisguard) String() string {
+	id := 0
+	if w.ID != nil {
+		id = *w.ID
+	}
+	return fmt.Sprintf("{ID: %d, DomainName: %s, Status: %s}", id, stringValue(w.DomainName), stringValue(w.Status))
+}
+
+// WhoisguardGetListArgs struct is an input arguments for WhoisguardService.GetList function
+type WhoisguardGetListArgs struct {
+	// Possible values are ALL, ALLOTED, FREE, DISCARD
+	// Default Value: ALL
+	ListType *string
+	// Page to return
+	// Default value: 1
+	Page *int
+	// Number of subscriptions to be listed on a page. Minimum value is 2, and maximum value is 100.
+	// Default value: 20
+	PageSize *int
+}
+
+// GetList gets the list of WhoisGuard privacy protection subscriptions
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/getlist/
+func (s *WhoisguardService) GetList(args *WhoisguardGetListArgs) (*WhoisguardGetListCommandResponse, error) {
+	return s.GetListContext(context.Background(), args)
+}
+
+// GetListContext is like GetList but uses the provided context for the request and any retries
+func (s *WhoisguardService) GetListContext(ctx context.Context, args *WhoisguardGetListArgs) (*WhoisguardGetListCommandResponse, error) {
+	params := map[string]string{
+		"Command": "namecheap.whoisguard.getList",
+	}
+
+	parsedArgsMap, err := parseWhoisguardGetListArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range *parsedArgsMap {
+		params[k] = v
+	}
+
+	return doCommand[WhoisguardGetListCommandResponse](ctx, s.client, params)
+}
+
+func parseWhoisguardGetListArgs(args *WhoisguardGetListArgs) (*map[string]string, error) {
+	params := map[string]string{}
+
+	if args == nil {
+		return &params, nil
+	}
+
+	if args.ListType != nil {
+		if isValidWhoisguardListType(*args.ListType) {
+			params["ListType"] = *args.ListType
+		} else {
+			return nil, fmt.Errorf("invalid ListType value: %s", *args.ListType)
+		}
+	}
+
+	if args.Page != nil {
+		if *args.Page > 0 {
+			params["Page"] = strconv.Itoa(*args.Page)
+		} else {
+			return nil, fmt.Errorf("invalid Page value: %d, minimum value is 1", *args.Page)
+		}
+	}
+
+	if args.PageSize != nil {
+		if *args.PageSize >= 2 && *args.PageSize <= 100 {
+			params["PageSize"] = strconv.Itoa(*args.PageSize)
+		} else {
+			return nil, fmt.Errorf("invalid PageSize value: %d, minimum value is 2, and maximum value is 100", *args.PageSize)
+		}
+	}
+
+	return &params, nil
+}
+
+func isValidWhoisguardListType(listType string) bool {
+	for _, value := range allowedWhoisguardListTypeValues {
+		if listType == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhoisguardGetList(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.whoisguard.getList</RequestedCommand>
+			<CommandResponse Type="namecheap.whoisguard.getList">
+				<WhoisguardGetListResult>
+					<Whoisguard ID="53536" DomainName="domain1.com" Created="08/31/2020" Expires="08/31/2021" Status="ENABLED" />
+					<Whoisguard ID="53537" DomainName="" Created="08/31/2020" Expires="08/31/2021" Status="FREE" />
+				</WhoisguardGetListResult>
+				<Paging>
+					<TotalItems>2</TotalItems>
+					<CurrentPage>1</CurrentPage>
+					<PageSize>20</PageSize>
+				</Paging>
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_data_passing", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Whoisguard.GetList(&WhoisguardGetListArgs{
+			ListType: String("ALLOTED"),
+			Page:     Int(2),
+			PageSize: Int(50),
+		})
+		if err != nil {
+			t.Fatal("Unable to get WhoisGuard list", err)
+		}
+
+		assert.Equal(t, "namecheap.whoisguard.getList", sentBody.Get("Command"))
+		assert.Equal(t, "ALLOTED", sentBody.Get("ListType"))
+		assert.Equal(t, "2", sentBody.Get("Page"))
+		assert.Equal(t, "50", sentBody.Get("PageSize"))
+	})
+
+	t.Run("request_data_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Whoisguard.GetList(&WhoisguardGetListArgs{ListType: String("EXPIRED")})
+		assert.EqualError(t, err, "invalid ListType value: EXPIRED")
+
+		_, err = client.Whoisguard.GetList(&WhoisguardGetListArgs{Page: Int(0)})
+		assert.EqualError(t, err, "invalid Page value: 0, minimum value is 1")
+
+		_, err = client.Whoisguard.GetList(&WhoisguardGetListArgs{PageSize: Int(1)})
+		assert.EqualError(t, err, "invalid PageSize value: 1, minimum value is 2, and maximum value is 100")
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Whoisguard.GetList(nil)
+		if err != nil {
+			t.Fatal("Unable to get WhoisGuard list", err)
+		}
+
+		whoisguards := *response.Whoisguards
+		assert.Len(t, whoisguards, 2)
+		assert.Equal(t, 53536, *whoisguards[0].ID)
+		assert.Equal(t, "domain1.com", *whoisguards[0].DomainName)
+		assert.Equal(t, time.Date(2020, 8, 31, 0, 0, 0, 0, time.UTC), whoisguards[0].Created.Time)
+		assert.Equal(t, time.Date(2021, 8, 31, 0, 0, 0, 0, time.UTC), whoisguards[0].Expires.Time)
+		assert.Equal(t, "ENABLED", *whoisguards[0].Status)
+		assert.Equal(t, "FREE", *whoisguards[1].Status)
+		assert.Equal(t, 2, *response.Paging.TotalItems)
+	})
+}
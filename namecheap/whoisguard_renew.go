@@ -0,0 +1,87 @@
+package namecheap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type WhoisguardRenewArgs struct {
+	Years         *int
+	PromotionCode *string
+}
+
+type WhoisguardRenewResponse = Response[WhoisguardRenewCommandResponse]
+
+type WhoisguardRenewCommandResponse struct {
+	WhoisguardRenewResult *WhoisguardRenewResult `xml:"WhoisguardRenewResult"`
+}
+
+type WhoisguardRenewResult struct {
+	WhoisguardID  *int    `xml:"WhoisguardId,attr"`
+	Years         *int    `xml:"Years,attr"`
+	Renew         *bool   `xml:"Renew,attr"`
+	OrderID       *int    `xml:"OrderId,attr"`
+	TransactionID *int    `xml:"TransactionId,attr"`
+	ChargedAmount *string `xml:"ChargedAmount,attr"`
+}
+
+func (r WhoisguardRenewResult) String() string {
+	whoisguardID := 0
+	if r.WhoisguardID != nil {
+		whoisguardID = *r.WhoisguardID
+	}
+	return fmt.Sprintf("{WhoisguardID: %d, ChargedAmount: %s}", whoisguardID, stringValue(r.ChargedAmount))
+}
+
+func validateWhoisguardRenewArgs(args *WhoisguardRenewArgs) error {
+	if args == nil || args.Years == nil {
+		return fmt.Errorf("Years is required")
+	}
+	if *args.Years < 1 || *args.Years > 9 {
+		return fmt.Errorf("Years must be between 1 and 9")
+	}
+	return nil
+}
+
+func parseWhoisguardRenewArgs(args *WhoisguardRenewArgs) (*map[string]string, error) {
+	err := validateWhoisguardRenewArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"Years": strconv.Itoa(*args.Years),
+	}
+
+	if args.PromotionCode != nil {
+		params["PromotionCode"] = *args.PromotionCode
+	}
+
+	return &params, nil
+}
+
+// Renew renews WhoisGuard privacy protection. The renewal is charged to the account.
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/renew/
+func (s *WhoisguardService) Renew(whoisguardID int, args *WhoisguardRenewArgs) (*WhoisguardRenewCommandResponse, error) {
+	return s.RenewContext(context.Background(), whoisguardID, args)
+}
+
+// RenewContext is like Renew but uses the provided context for the request and any retries
+func (s *WhoisguardService) RenewContext(ctx context.Context, whoisguardID int, args *WhoisguardRenewArgs) (*WhoisguardRenewCommandResponse, error) {
+	params := map[string]string{
+		"Command":      "namecheap.whoisguard.renew",
+		"WhoisguardID": strconv.Itoa(whoisguardID),
+	}
+
+	parsedArgs, err := parseWhoisguardRenewArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range *parsedArgs {
+		params[k] = v
+	}
+
+	return doCommand[WhoisguardRenewCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,86 @@
+package namecheap
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhoisguardRenew(t *testing.T) {
+	fakeResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.whoisguard.renew</RequestedCommand>
+			<CommandResponse Type="namecheap.whoisguard.renew">
+				<WhoisguardRenewResult WhoisguardId="53536" Years="2" Renew="true" OrderId="1234" TransactionId="4321" ChargedAmount="5.7600" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	t.Run("request_command", func(t *testing.T) {
+		var sentBody url.Values
+
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			body, _ := io.ReadAll(request.Body)
+			query, _ := url.ParseQuery(string(body))
+			sentBody = query
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		_, err := client.Whoisguard.Renew(53536, &WhoisguardRenewArgs{Years: Int(2), PromotionCode: String("PROMO123")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.whoisguard.renew", err)
+		}
+
+		assert.Equal(t, "namecheap.whoisguard.renew", sentBody.Get("Command"))
+		assert.Equal(t, "53536", sentBody.Get("WhoisguardID"))
+		assert.Equal(t, "2", sentBody.Get("Years"))
+		assert.Equal(t, "PROMO123", sentBody.Get("PromotionCode"))
+	})
+
+	t.Run("correct_parsing_result", func(t *testing.T) {
+		mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(fakeResponse))
+		}))
+		defer mockServer.Close()
+
+		client := setupClient(nil)
+		client.BaseURL = mockServer.URL
+
+		response, err := client.Whoisguard.Renew(53536, &WhoisguardRenewArgs{Years: Int(2), PromotionCode: String("PROMO123")})
+		if err != nil {
+			t.Fatal("Unable to call namecheap.whoisguard.renew", err)
+		}
+
+		result := response.WhoisguardRenewResult
+		assert.Equal(t, 53536, *result.WhoisguardID)
+		assert.Equal(t, 2, *result.Years)
+		assert.True(t, *result.Renew)
+		assert.Equal(t, 1234, *result.OrderID)
+		assert.Equal(t, 4321, *result.TransactionID)
+		assert.Equal(t, "5.7600", *result.ChargedAmount)
+	})
+
+	t.Run("validation_errors", func(t *testing.T) {
+		client := setupClient(nil)
+
+		_, err := client.Whoisguard.Renew(53536, nil)
+		assert.EqualError(t, err, "Years is required")
+
+		_, err = client.Whoisguard.Renew(53536, &WhoisguardRenewArgs{Years: Int(10)})
+		assert.EqualError(t, err, "Years must be between 1 and 9")
+	})
+}
@@ -0,0 +1,129 @@
+package namecheap
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhoisguardByDomain(t *testing.T) {
+	fakeGetInfoResponse := `
+		<?xml version="1.0" encoding="utf-8"?>
+		<ApiResponse Status="OK" xmlns="http://api.namecheap.com/xml.response">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.domains.getinfo</RequestedCommand>
+			<CommandResponse Type="namecheap.domains.getInfo">
+				<DomainGetInfoResult ID="57582" DomainName="%s" OwnerName="anUser" IsOwner="true" IsPremium="false">
+					<Whoisguard Enabled="%s">
+						<ID>%d</ID>
+					</Whoisguard>
+				</DomainGetInfoResult>
+			</CommandResponse>
+			<Server>PHX01APIEXT12</Server>
+			<GMTTimeDifference>--5:00</GMTTimeDifference>
+			<ExecutionTime>0.013</ExecutionTime>
+		</ApiResponse>
+	`
+	fakeEnableResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.whoisguard.enable</RequestedCommand>
+			<CommandResponse Type="namecheap.whoisguard.enable">
+				<WhoisguardEnableResult DomainName="domain1.com" IsSuccess="true" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+	fakeDisableResponse := `
+		<?xml version="1.0" encoding="UTF-8"?>
+		<ApiResponse xmlns="http://api.namecheap.com/xml.response" Status="OK">
+			<Errors />
+			<Warnings />
+			<RequestedCommand>namecheap.whoisguard.disable</RequestedCommand>
+			<CommandResponse Type="namecheap.whoisguard.disable">
+				<WhoisguardDisableResult DomainName="domain1.com" IsSuccess="true" />
+			</CommandResponse>
+			<Server>SERVER-NAME</Server>
+			<GMTTimeDifference>+5</GMTTimeDifference>
+			<ExecutionTime>0.078</ExecutionTime>
+		</ApiResponse>
+	`
+
+	var sentBodies []url.Values
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		query, _ := url.ParseQuery(string(body))
+		sentBodies = append(sentBodies, query)
+
+		switch query.Get("Command") {
+		case "namecheap.domains.getInfo":
+			if query.Get("DomainName") == "domain1.com" {
+				_, _ = writer.Write([]byte(fmt.Sprintf(fakeGetInfoResponse, "domain1.com", "True", 53536)))
+			} else {
+				_, _ = writer.Write([]byte(fmt.Sprintf(fakeGetInfoResponse, query.Get("DomainName"), "NotAlloted", 0)))
+			}
+		case "namecheap.whoisguard.enable":
+			_, _ = writer.Write([]byte(fakeEnableResponse))
+		case "namecheap.whoisguard.disable":
+			_, _ = writer.Write([]byte(fakeDisableResponse))
+		}
+	}))
+	defer mockServer.Close()
+
+	client := setupClient(nil)
+	client.BaseURL = mockServer.URL
+
+	t.Run("get_id_by_domain", func(t *testing.T) {
+		id, err := client.Whoisguard.GetIDByDomain("domain1.com")
+		if err != nil {
+			t.Fatal("Unable to get WhoisGuard ID", err)
+		}
+
+		assert.Equal(t, 53536, id)
+	})
+
+	t.Run("get_id_by_domain_not_alloted", func(t *testing.T) {
+		_, err := client.Whoisguard.GetIDByDomain("domain2.com")
+
+		assert.EqualError(t, err, "no WhoisGuard subscription is allotted to domain2.com")
+	})
+
+	t.Run("enable_by_domain", func(t *testing.T) {
+		sentBodies = nil
+
+		response, err := client.Whoisguard.EnableByDomain("domain1.com", "john@example.com")
+		if err != nil {
+			t.Fatal("Unable to enable WhoisGuard", err)
+		}
+
+		assert.True(t, *response.WhoisguardEnableResult.IsSuccess)
+		assert.Len(t, sentBodies, 2)
+		assert.Equal(t, "namecheap.whoisguard.enable", sentBodies[1].Get("Command"))
+		assert.Equal(t, "53536", sentBodies[1].Get("WhoisguardID"))
+		assert.Equal(t, "john@example.com", sentBodies[1].Get("ForwardedToEmail"))
+	})
+
+	t.Run("disable_by_domain", func(t *testing.T) {
+		sentBodies = nil
+
+		response, err := client.Whoisguard.DisableByDomain("domain1.com")
+		if err != nil {
+			t.Fatal("Unable to disable WhoisGuard", err)
+		}
+
+		assert.True(t, *response.WhoisguardDisableResult.IsSuccess)
+		assert.Len(t, sentBodies, 2)
+		assert.Equal(t, "53536", sentBodies[1].Get("WhoisguardID"))
+	})
+}
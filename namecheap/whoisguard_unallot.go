@@ -0,0 +1,34 @@
+package namecheap
+
+import (
+	"context"
+	"strconv"
+)
+
+type WhoisguardUnallotResponse = Response[WhoisguardUnallotCommandResponse]
+
+type WhoisguardUnallotCommandResponse struct {
+	WhoisguardUnallotResult *WhoisguardUnallotResult `xml:"WhoisguardUnallotResult"`
+}
+
+type WhoisguardUnallotResult struct {
+	WhoisguardID *int  `xml:"WhoisguardId,attr"`
+	IsSuccess    *bool `xml:"IsSuccess,attr"`
+}
+
+// Unallot unallots WhoisGuard privacy protection from the domain it is allotted to
+//
+// Namecheap doc: https://www.namecheap.com/support/api/methods/whoisguard/unallot/
+func (s *WhoisguardService) Unallot(whoisguardID int) (*WhoisguardUnallotCommandResponse, error) {
+	return s.UnallotContext(context.Background(), whoisguardID)
+}
+
+// UnallotContext is like Unallot but uses the provided context for the request and any retries
+func (s *WhoisguardService) UnallotContext(ctx context.Context, whoisguardID int) (*WhoisguardUnallotCommandResponse, error) {
+	params := map[string]string{
+		"Command":      "namecheap.whoisguard.unallot",
+		"WhoisguardID": strconv.Itoa(whoisguardID),
+	}
+
+	return doCommand[WhoisguardUnallotCommandResponse](ctx, s.client, params)
+}
@@ -0,0 +1,346 @@
+package namecheaptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// Scrubbed replaces the credentials and the secrets in the recorded requests
+const Scrubbed = "SCRUBBED"
+
+// scrubbedParams are the request params holding the credentials, they are never written to a cassette
+// and never compared when replaying, like the namecheap.SecretParams
+var scrubbedParams = []string{"ApiKey", "ApiUser", "UserName", "ClientIp"}
+
+// Cassette is a sequence of recorded API requests and their responses.
+//
+// Cassettes are recorded by a Recorder, e.g. against the sandbox, saved as JSON files
+// and served back offline by a Replayer.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response
+type Interaction struct {
+	Command string `json:"command"`
+	// Params are the form params of the request without the credentials
+	Params   url.Values       `json:"params"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedResponse is the response of an Interaction
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// LoadCassette reads a cassette saved by Cassette.Save or Recorder.Save
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("error decoding cassette %s: %w", path, err)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette as a JSON file
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Recorder is an http.RoundTripper recording the requests sent through it and their responses.
+// The credentials and the namecheap.SecretParams, e.g. the passwords and the EPP codes, are scrubbed from the recorded params.
+//
+//	recorder := namecheaptest.NewRecorder(nil)
+//	client := namecheap.NewClient(sandboxOptions, namecheap.WithHTTPClient(&http.Client{Transport: recorder}))
+//
+//	// ... call the API
+//
+//	err := recorder.Save("testdata/get_hosts.json")
+type Recorder struct {
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a Recorder sending the requests with the transport, or with http.DefaultTransport when nil
+func NewRecorder(transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Recorder{transport: transport}
+}
+
+// RoundTrip sends the request and records it with its response. Failed requests are not recorded.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	params, err := requestParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Command:  params.Get("Command"),
+		Params:   scrubParams(params),
+		Response: RecordedResponse{StatusCode: resp.StatusCode, Header: header, Body: string(body)},
+	})
+
+	return resp, nil
+}
+
+// Cassette returns a copy of the interactions recorded so far
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &Cassette{Interactions: append([]Interaction{}, r.cassette.Interactions...)}
+}
+
+// Save writes the interactions recorded so far as a JSON file
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+// Replayer is an http.RoundTripper serving the responses of a cassette without network access.
+//
+// A request is answered by the first interaction not replayed yet with the same Command and params,
+// ignoring the credentials and IgnoreParams, so repeated requests get the responses in the recorded order.
+// Requests without such interaction fail like network failures, which the default retry policy retries,
+// so replaying clients usually disable the retries:
+//
+//	cassette, err := namecheaptest.LoadCassette("testdata/get_hosts.json")
+//	client := namecheap.NewClient(options,
+//		namecheap.WithHTTPClient(&http.Client{Transport: namecheaptest.NewReplayer(cassette)}),
+//		namecheap.WithRetryPolicy(nil),
+//	)
+type Replayer struct {
+	// IgnoreParams are the params not compared in addition to the credentials,
+	// e.g. params holding timestamps or generated values
+	IgnoreParams []string
+
+	cassette *Cassette
+
+	mu       sync.Mutex
+	replayed []bool
+}
+
+// NewReplayer returns a Replayer serving the responses of the cassette
+func NewReplayer(cassette *Cassette) *Replayer {
+	return &Replayer{
+		cassette: cassette,
+		replayed: make([]bool, len(cassette.Interactions)),
+	}
+}
+
+// RoundTrip answers the request with the matching recorded response
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	params, err := requestParams(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !r.matches(interaction, params) {
+			continue
+		}
+		r.replayed[i] = true
+
+		response := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+			StatusCode:    response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(response.Body)),
+			ContentLength: int64(len(response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction left for %s with params %s", params.Get("Command"), formatParams(r.significant(params)))
+}
+
+// Unused returns the interactions not replayed yet, e.g. to check that a test sent every recorded request
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.replayed[i] {
+			unused = append(unused, interaction)
+		}
+	}
+
+	return unused
+}
+
+func (r *Replayer) matches(interaction Interaction, params url.Values) bool {
+	if !strings.EqualFold(interaction.Command, params.Get("Command")) {
+		return false
+	}
+
+	recorded := r.significant(interaction.Params)
+	requested := r.significant(params)
+	if len(recorded) != len(requested) {
+		return false
+	}
+
+	for key, values := range requested {
+		recordedValues, ok := recorded[key]
+		if !ok || len(recordedValues) != len(values) {
+			return false
+		}
+		for i := range values {
+			if recordedValues[i] != values[i] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// significant returns the params compared when matching the requests, with lowercase keys
+// as the API doesn't distinguish the case of the param names
+func (r *Replayer) significant(params url.Values) url.Values {
+	significant := url.Values{}
+
+	for key, values := range params {
+		if isScrubbedParam(key) || isParam(key, r.IgnoreParams) {
+			continue
+		}
+		significant[strings.ToLower(key)] = values
+	}
+
+	return significant
+}
+
+// requestParams returns the form params of the request without consuming its body
+func requestParams(req *http.Request) (url.Values, error) {
+	params := req.URL.Query()
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return params, nil
+	}
+
+	var body []byte
+	var err error
+
+	if req.GetBody != nil {
+		var reader io.ReadCloser
+		reader, err = req.GetBody()
+		if err == nil {
+			body, err = io.ReadAll(reader)
+			_ = reader.Close()
+		}
+	} else {
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing request body: %w", err)
+	}
+	for key, values := range form {
+		params[key] = append(params[key], values...)
+	}
+
+	return params, nil
+}
+
+func scrubParams(params url.Values) url.Values {
+	scrubbed := url.Values{}
+
+	for key, values := range params {
+		if isScrubbedParam(key) {
+			scrubbed[key] = []string{Scrubbed}
+			continue
+		}
+		scrubbed[key] = append([]string{}, values...)
+	}
+
+	return scrubbed
+}
+
+func isScrubbedParam(key string) bool {
+	return isParam(key, scrubbedParams) || namecheap.IsSecretParam(key)
+}
+
+func isParam(key string, names []string) bool {
+	for _, name := range names {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatParams(params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, strings.Join(params[key], ",")))
+	}
+
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
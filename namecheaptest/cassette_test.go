@@ -0,0 +1,188 @@
+package namecheaptest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func TestCassette(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.AddDomain(Domain{Name: "domain.com", Hosts: []Host{{Name: "@", Type: "A", Address: "10.11.12.13"}}})
+
+	recorder := NewRecorder(nil)
+	client := server.Client(namecheap.WithHTTPClient(&http.Client{Transport: recorder}))
+
+	_, err := client.DomainsDNS.GetHosts("domain.com")
+	if err != nil {
+		t.Fatal("Unable to get hosts", err)
+	}
+	_, err = client.DomainsDNS.UpsertRecord("domain.com", namecheap.DomainsDNSHostRecord{
+		HostName:   namecheap.String("www"),
+		RecordType: namecheap.String("A"),
+		Address:    namecheap.String("10.11.12.14"),
+	})
+	if err != nil {
+		t.Fatal("Unable to upsert record", err)
+	}
+	_, err = client.Domains.GetInfo("missing.com")
+	assert.True(t, namecheap.IsDomainNotFound(err))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := recorder.Save(path); err != nil {
+		t.Fatal("Unable to save cassette", err)
+	}
+	server.Close()
+
+	t.Run("scrubbed", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NotContains(t, string(data), DefaultAPIKey)
+		assert.NotContains(t, string(data), DefaultClientIP)
+
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			t.Fatal("Unable to load cassette", err)
+		}
+		// getHosts, then getHosts, getHosts and setHosts of the upsert, then getInfo
+		assert.Len(t, cassette.Interactions, 5)
+		assert.Equal(t, "namecheap.domains.dns.getHosts", cassette.Interactions[0].Command)
+		assert.Equal(t, Scrubbed, cassette.Interactions[0].Params.Get("ApiKey"))
+		assert.Equal(t, Scrubbed, cassette.Interactions[0].Params.Get("Username"))
+		assert.Equal(t, "domain", cassette.Interactions[0].Params.Get("SLD"))
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			t.Fatal("Unable to load cassette", err)
+		}
+		replayer := NewReplayer(cassette)
+
+		client := namecheap.NewClient(&namecheap.ClientOptions{
+			UserName: "other",
+			ApiUser:  "other",
+			ApiKey:   "other-key",
+			ClientIp: "10.0.0.1",
+		}, namecheap.WithHTTPClient(&http.Client{Transport: replayer}), namecheap.WithRetryPolicy(nil))
+
+		response, err := client.DomainsDNS.GetHosts("domain.com")
+		if err != nil {
+			t.Fatal("Unable to get hosts", err)
+		}
+		assert.Len(t, *response.DomainDNSGetHostsResult.Hosts, 1)
+
+		_, err = client.DomainsDNS.UpsertRecord("domain.com", namecheap.DomainsDNSHostRecord{
+			HostName:   namecheap.String("www"),
+			RecordType: namecheap.String("A"),
+			Address:    namecheap.String("10.11.12.14"),
+		})
+		assert.Nil(t, err)
+
+		_, err = client.Domains.GetInfo("missing.com")
+		assert.True(t, namecheap.IsDomainNotFound(err))
+
+		assert.Empty(t, replayer.Unused())
+
+		_, err = client.DomainsDNS.GetHosts("domain.com")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no recorded interaction left for namecheap.domains.dns.getHosts")
+	})
+
+	t.Run("params_mismatch", func(t *testing.T) {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			t.Fatal("Unable to load cassette", err)
+		}
+		replayer := NewReplayer(cassette)
+		client := namecheap.NewClient(&namecheap.ClientOptions{}, namecheap.WithHTTPClient(&http.Client{Transport: replayer}), namecheap.WithRetryPolicy(nil))
+
+		_, err = client.DomainsDNS.GetHosts("other.com")
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "sld=other")
+		assert.Len(t, replayer.Unused(), 5)
+
+		replayer.IgnoreParams = []string{"SLD"}
+
+		_, err = client.DomainsDNS.GetHosts("other.com")
+		assert.Nil(t, err)
+		assert.Len(t, replayer.Unused(), 4)
+		assert.True(t, strings.EqualFold("namecheap.domains.dns.getHosts", replayer.Unused()[0].Command))
+	})
+}
+
+func TestRecorderSecretParams(t *testing.T) {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		_, _ = writer.Write([]byte(`
+			<?xml version="1.0" encoding="utf-8"?>
+			<ApiResponse Status="ERROR" xmlns="http://api.namecheap.com/xml.response">
+				<Errors>
+					<Error Number="2011170">Validation error</Error>
+				</Errors>
+			</ApiResponse>
+		`))
+	}))
+	defer apiServer.Close()
+
+	recorder := NewRecorder(nil)
+	client := namecheap.NewClient(&namecheap.ClientOptions{
+		UserName:   "user",
+		ApiUser:    "user",
+		ApiKey:     DefaultAPIKey,
+		ClientIp:   DefaultClientIP,
+		UseSandbox: true,
+	}, namecheap.WithBaseURL(apiServer.URL), namecheap.WithHTTPClient(&http.Client{Transport: recorder}), namecheap.WithRetryPolicy(nil))
+
+	_, _ = client.Users.ChangePassword(&namecheap.ChangePasswordArgs{
+		ResetCode:   namecheap.String("reset-secret"),
+		NewPassword: namecheap.String("new-secret"),
+	})
+	_, _ = client.DomainsTransfer.Create(&namecheap.DomainsTransferCreateArgs{
+		DomainName: namecheap.String("domain.com"),
+		EPPCode:    namecheap.String("epp-secret"),
+	})
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := recorder.Save(path); err != nil {
+		t.Fatal("Unable to save cassette", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"reset-secret", "new-secret", "epp-secret", DefaultAPIKey} {
+		assert.NotContains(t, string(data), secret)
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatal("Unable to load cassette", err)
+	}
+	if assert.Len(t, cassette.Interactions, 2) {
+		assert.Equal(t, Scrubbed, cassette.Interactions[0].Params.Get("ResetCode"))
+		assert.Equal(t, Scrubbed, cassette.Interactions[0].Params.Get("NewPassword"))
+		assert.Equal(t, Scrubbed, cassette.Interactions[1].Params.Get("EPPCode"))
+		assert.Equal(t, "domain.com", cassette.Interactions[1].Params.Get("DomainName"))
+	}
+
+	replayer := NewReplayer(cassette)
+	client = namecheap.NewClient(&namecheap.ClientOptions{}, namecheap.WithHTTPClient(&http.Client{Transport: replayer}), namecheap.WithRetryPolicy(nil))
+
+	_, err = client.DomainsTransfer.Create(&namecheap.DomainsTransferCreateArgs{
+		DomainName: namecheap.String("domain.com"),
+		EPPCode:    namecheap.String("other-code"),
+	})
+	assert.True(t, namecheap.IsAPIErrorNumber(err, "2011170"))
+}
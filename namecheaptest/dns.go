@@ -0,0 +1,206 @@
+package namecheaptest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const errorNumberNotUsingOurDNS = "2030288"
+
+type domainDNSGetHostsResult struct {
+	XMLName       xml.Name   `xml:"DomainDNSGetHostsResult"`
+	Domain        string     `xml:"Domain,attr"`
+	EmailType     string     `xml:"EmailType,attr"`
+	IsUsingOurDNS bool       `xml:"IsUsingOurDNS,attr"`
+	Hosts         []hostItem `xml:"host"`
+}
+
+type hostItem struct {
+	HostID             int    `xml:"HostId,attr"`
+	Name               string `xml:"Name,attr"`
+	Type               string `xml:"Type,attr"`
+	Address            string `xml:"Address,attr"`
+	MXPref             int    `xml:"MXPref,attr"`
+	TTL                int    `xml:"TTL,attr"`
+	AssociatedAppTitle string `xml:"AssociatedAppTitle,attr"`
+	FriendlyName       string `xml:"FriendlyName,attr"`
+	IsActive           bool   `xml:"IsActive,attr"`
+	IsDDNSEnabled      bool   `xml:"IsDDNSEnabled,attr"`
+}
+
+// dnsDomain returns the domain named by the SLD and TLD parameters, which must use the Namecheap DNS
+func (s *Server) dnsDomain(params url.Values) (*Domain, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(domain.Nameservers) > 0 {
+		return nil, &Error{
+			Number:  errorNumberNotUsingOurDNS,
+			Message: fmt.Sprintf("%s is not using proper DNS servers", domain.Name),
+		}
+	}
+
+	return domain, nil
+}
+
+func (s *Server) dnsGetHosts(params url.Values) (interface{}, *Error) {
+	domain, err := s.dnsDomain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := domainDNSGetHostsResult{
+		Domain:        domain.Name,
+		EmailType:     domain.EmailType,
+		IsUsingOurDNS: true,
+		Hosts:         []hostItem{},
+	}
+
+	for _, host := range domain.Hosts {
+		result.Hosts = append(result.Hosts, hostItem{
+			HostID:   host.ID,
+			Name:     host.Name,
+			Type:     host.Type,
+			Address:  host.Address,
+			MXPref:   host.MXPref,
+			TTL:      host.TTL,
+			IsActive: true,
+		})
+	}
+
+	return result, nil
+}
+
+type domainDNSSetHostsResult struct {
+	XMLName   xml.Name `xml:"DomainDNSSetHostsResult"`
+	Domain    string   `xml:"Domain,attr"`
+	IsSuccess bool     `xml:"IsSuccess,attr"`
+}
+
+func (s *Server) dnsSetHosts(params url.Values) (interface{}, *Error) {
+	domain, apiErr := s.dnsDomain(params)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	var hosts []Host
+	for i := 1; params.Get("RecordType"+strconv.Itoa(i)) != ""; i++ {
+		index := strconv.Itoa(i)
+
+		host := Host{
+			Name:    params.Get("HostName" + index),
+			Type:    params.Get("RecordType" + index),
+			Address: params.Get("Address" + index),
+		}
+		if host.Name == "" || host.Address == "" {
+			return nil, invalidParameter("HostName%s and Address%s are required", index, index)
+		}
+
+		if value := params.Get("TTL" + index); value != "" {
+			ttl, err := strconv.Atoi(value)
+			if err != nil || ttl < 60 || ttl > 60000 {
+				return nil, invalidParameter("TTL%s is invalid", index)
+			}
+			host.TTL = ttl
+		}
+
+		if value := params.Get("MXPref" + index); value != "" {
+			mxPref, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, invalidParameter("MXPref%s is invalid", index)
+			}
+			host.MXPref = mxPref
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	emailType := domain.EmailType
+	if value := params.Get("EmailType"); value != "" {
+		emailType = strings.ToUpper(value)
+	}
+
+	for _, host := range hosts {
+		if strings.EqualFold(host.Type, "MX") && emailType != "MX" {
+			return nil, invalidParameter("MX records are only allowed with EmailType MX")
+		}
+	}
+
+	domain.EmailType = emailType
+	domain.Hosts = nil
+	for _, host := range hosts {
+		domain.Hosts = append(domain.Hosts, s.normalizeHost(host))
+	}
+
+	return domainDNSSetHostsResult{Domain: domain.Name, IsSuccess: true}, nil
+}
+
+type domainDNSGetListResult struct {
+	XMLName        xml.Name `xml:"DomainDNSGetListResult"`
+	Domain         string   `xml:"Domain,attr"`
+	IsUsingOurDNS  bool     `xml:"IsUsingOurDNS,attr"`
+	IsPremiumDNS   bool     `xml:"IsPremiumDNS,attr"`
+	IsUsingFreeDNS bool     `xml:"IsUsingFreeDNS,attr"`
+	Nameservers    []string `xml:"Nameserver"`
+}
+
+func (s *Server) dnsGetList(params url.Values) (interface{}, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := domainDNSGetListResult{
+		Domain:        domain.Name,
+		IsUsingOurDNS: len(domain.Nameservers) == 0,
+		Nameservers:   domain.Nameservers,
+	}
+	if result.IsUsingOurDNS {
+		result.Nameservers = defaultNameservers
+	}
+
+	return result, nil
+}
+
+type domainDNSUpdatedResult struct {
+	XMLName xml.Name
+	Domain  string `xml:"Domain,attr"`
+	Updated bool   `xml:"Updated,attr"`
+}
+
+func (s *Server) dnsSetCustom(params url.Values) (interface{}, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var nameservers []string
+	for _, nameserver := range strings.Split(params.Get("Nameservers"), ",") {
+		if nameserver = strings.TrimSpace(nameserver); nameserver != "" {
+			nameservers = append(nameservers, strings.ToLower(nameserver))
+		}
+	}
+	if len(nameservers) < 2 {
+		return nil, invalidParameter("At least two Nameservers are required")
+	}
+
+	domain.Nameservers = nameservers
+
+	return domainDNSUpdatedResult{XMLName: xml.Name{Local: "DomainDNSSetCustomResult"}, Domain: domain.Name, Updated: true}, nil
+}
+
+func (s *Server) dnsSetDefault(params url.Values) (interface{}, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	domain.Nameservers = nil
+
+	return domainDNSUpdatedResult{XMLName: xml.Name{Local: "DomainDNSSetDefaultResult"}, Domain: domain.Name, Updated: true}, nil
+}
@@ -0,0 +1,144 @@
+package namecheaptest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func TestDNS(t *testing.T) {
+	t.Run("set_and_get_hosts", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.AddDomain(Domain{Name: "domain.com"})
+		client := server.Client()
+
+		_, err := client.DomainsDNS.SetHosts(&namecheap.DomainsDNSSetHostsArgs{
+			Domain:    namecheap.String("domain.com"),
+			EmailType: namecheap.String(namecheap.EmailTypeMX),
+			Records: &[]namecheap.DomainsDNSHostRecord{
+				{HostName: namecheap.String("@"), RecordType: namecheap.String("A"), Address: namecheap.String("10.11.12.13")},
+				{HostName: namecheap.String("www"), RecordType: namecheap.String("CNAME"), Address: namecheap.String("domain.com"), TTL: namecheap.Int(300)},
+				{HostName: namecheap.String("@"), RecordType: namecheap.String("MX"), Address: namecheap.String("mx.domain.com"), MXPref: namecheap.UInt8(20)},
+			},
+		})
+		if err != nil {
+			t.Fatal("Unable to set hosts", err)
+		}
+
+		response, err := client.DomainsDNS.GetHosts("domain.com")
+		if err != nil {
+			t.Fatal("Unable to get hosts", err)
+		}
+
+		result := response.DomainDNSGetHostsResult
+		assert.Equal(t, namecheap.EmailTypeMX, *result.EmailType)
+		assert.Len(t, *result.Hosts, 3)
+
+		www := (*result.Hosts)[1]
+		assert.Equal(t, "domain.com.", *www.Address)
+		assert.Equal(t, 300, *www.TTL)
+		assert.Equal(t, 10, *www.MXPref)
+		assert.Equal(t, 20, *(*result.Hosts)[2].MXPref)
+		assert.Equal(t, 1800, *(*result.Hosts)[0].TTL)
+	})
+
+	t.Run("mx_requires_email_type", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.AddDomain(Domain{Name: "domain.com"})
+
+		_, err := server.Client().DomainsDNS.SetHosts(&namecheap.DomainsDNSSetHostsArgs{
+			Domain: namecheap.String("domain.com"),
+			Records: &[]namecheap.DomainsDNSHostRecord{
+				{HostName: namecheap.String("@"), RecordType: namecheap.String("MX"), Address: namecheap.String("mx.domain.com")},
+			},
+		})
+		assert.NotNil(t, err)
+
+		domain, _ := server.Domain("domain.com")
+		assert.Empty(t, domain.Hosts)
+	})
+
+	t.Run("zone", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.AddDomain(Domain{
+			Name:  "domain.com",
+			Hosts: []Host{{Name: "@", Type: "A", Address: "10.11.12.13"}, {Name: "old", Type: "A", Address: "10.0.0.1"}},
+		})
+		client := server.Client()
+
+		plan, err := client.DomainsDNS.ApplyZone(&namecheap.Zone{
+			Domain: "domain.com",
+			Records: []namecheap.DomainsDNSHostRecord{
+				{HostName: namecheap.String("@"), RecordType: namecheap.String("A"), Address: namecheap.String("10.11.12.13")},
+				{HostName: namecheap.String("www"), RecordType: namecheap.String("CNAME"), Address: namecheap.String("domain.com.")},
+			},
+		})
+		if err != nil {
+			t.Fatal("Unable to apply zone", err)
+		}
+		assert.Len(t, plan.Changes, 2)
+
+		_, err = client.DomainsDNS.UpsertRecord("domain.com", namecheap.DomainsDNSHostRecord{
+			HostName:   namecheap.String("www"),
+			RecordType: namecheap.String("CNAME"),
+			Address:    namecheap.String("other.com"),
+		})
+		if err != nil {
+			t.Fatal("Unable to upsert record", err)
+		}
+
+		plan, err = client.DomainsDNS.PlanZone(&namecheap.Zone{
+			Domain: "domain.com",
+			Records: []namecheap.DomainsDNSHostRecord{
+				{HostName: namecheap.String("@"), RecordType: namecheap.String("A"), Address: namecheap.String("10.11.12.13")},
+				{HostName: namecheap.String("www"), RecordType: namecheap.String("CNAME"), Address: namecheap.String("other.com")},
+			},
+		})
+		if err != nil {
+			t.Fatal("Unable to plan zone", err)
+		}
+		assert.True(t, plan.IsEmpty())
+	})
+
+	t.Run("custom_nameservers", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.AddDomain(Domain{Name: "domain.com"})
+		client := server.Client()
+
+		_, err := client.DomainsDNS.SetCustom("domain.com", []string{"ns1.other.net"})
+		assert.NotNil(t, err)
+
+		_, err = client.DomainsDNS.SetCustom("domain.com", []string{"ns1.other.net", "ns2.other.net"})
+		if err != nil {
+			t.Fatal("Unable to set custom nameservers", err)
+		}
+
+		list, err := client.DomainsDNS.GetList("domain.com")
+		if err != nil {
+			t.Fatal("Unable to get nameservers", err)
+		}
+		assert.False(t, *list.DomainDNSGetListResult.IsUsingOurDNS)
+		assert.Equal(t, []string{"ns1.other.net", "ns2.other.net"}, *list.DomainDNSGetListResult.Nameservers)
+
+		_, err = client.DomainsDNS.GetHosts("domain.com")
+		assert.True(t, namecheap.IsAPIErrorNumber(err, "2030288"))
+
+		_, err = client.DomainsDNS.SetDefault("domain.com")
+		if err != nil {
+			t.Fatal("Unable to set default nameservers", err)
+		}
+
+		_, err = client.DomainsDNS.GetHosts("domain.com")
+		assert.Nil(t, err)
+	})
+}
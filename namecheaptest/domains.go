@@ -0,0 +1,469 @@
+package namecheaptest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+const (
+	dateLayout = "01/02/2006"
+
+	// expiringWithin is the period used by ListType EXPIRING
+	expiringWithin = 30 * 24 * time.Hour
+
+	errorNumberDomainNotAvailable = "3019166"
+	errorNumberTLDNotSupported    = "2030280"
+	errorNumberInvalidParameter   = "2010324"
+	errorNumberOrderFailed        = "2528166"
+)
+
+var defaultNameservers = []string{"dns1.registrar-servers.com", "dns2.registrar-servers.com"}
+
+func domainNotFound(name string) *Error {
+	return &Error{Number: namecheap.ErrorNumberDomainNotFound, Message: fmt.Sprintf("Domain name not found: %s", name)}
+}
+
+func invalidParameter(format string, args ...interface{}) *Error {
+	return &Error{Number: errorNumberInvalidParameter, Message: fmt.Sprintf(format, args...)}
+}
+
+// domain returns the domain named by the DomainName parameter, or by the SLD and TLD parameters
+func (s *Server) domain(params url.Values) (*Domain, *Error) {
+	name := params.Get("DomainName")
+	if name == "" {
+		if params.Get("SLD") == "" || params.Get("TLD") == "" {
+			return nil, invalidParameter("DomainName is missing")
+		}
+		name = params.Get("SLD") + "." + params.Get("TLD")
+	}
+
+	domain, ok := s.domains[strings.ToLower(name)]
+	if !ok {
+		return nil, domainNotFound(name)
+	}
+
+	return domain, nil
+}
+
+type domainGetListResult struct {
+	XMLName xml.Name         `xml:"DomainGetListResult"`
+	Domains []domainListItem `xml:"Domain"`
+}
+
+type domainListItem struct {
+	ID         int    `xml:"ID,attr"`
+	Name       string `xml:"Name,attr"`
+	User       string `xml:"User,attr"`
+	Created    string `xml:"Created,attr"`
+	Expires    string `xml:"Expires,attr"`
+	IsExpired  bool   `xml:"IsExpired,attr"`
+	IsLocked   bool   `xml:"IsLocked,attr"`
+	AutoRenew  bool   `xml:"AutoRenew,attr"`
+	WhoisGuard string `xml:"WhoisGuard,attr"`
+	IsPremium  bool   `xml:"IsPremium,attr"`
+	IsOurDNS   bool   `xml:"IsOurDNS,attr"`
+}
+
+type paging struct {
+	XMLName     xml.Name `xml:"Paging"`
+	TotalItems  int      `xml:"TotalItems"`
+	CurrentPage int      `xml:"CurrentPage"`
+	PageSize    int      `xml:"PageSize"`
+}
+
+func (s *Server) domainsGetList(params url.Values) (interface{}, *Error) {
+	page, pageSize := 1, 20
+	if value := params.Get("Page"); value != "" {
+		page, _ = strconv.Atoi(value)
+	}
+	if value := params.Get("PageSize"); value != "" {
+		pageSize, _ = strconv.Atoi(value)
+	}
+	if page < 1 || pageSize < 10 || pageSize > 100 {
+		return nil, invalidParameter("Invalid Page or PageSize")
+	}
+
+	now := s.now()
+	listType := strings.ToUpper(params.Get("ListType"))
+	searchTerm := strings.ToLower(params.Get("SearchTerm"))
+
+	var domains []*Domain
+	for _, domain := range s.domains {
+		switch listType {
+		case "EXPIRING":
+			if domain.Expires.Before(now) || domain.Expires.Sub(now) > expiringWithin {
+				continue
+			}
+		case "EXPIRED":
+			if !domain.Expires.Before(now) {
+				continue
+			}
+		}
+		if searchTerm != "" && !strings.Contains(domain.Name, searchTerm) {
+			continue
+		}
+		domains = append(domains, domain)
+	}
+
+	sortBy := strings.ToUpper(params.Get("SortBy"))
+	sort.Slice(domains, func(i, j int) bool {
+		a, b := domains[i], domains[j]
+		if strings.HasSuffix(sortBy, "_DESC") {
+			a, b = b, a
+		}
+		switch strings.TrimSuffix(sortBy, "_DESC") {
+		case "EXPIREDATE":
+			if !a.Expires.Equal(b.Expires) {
+				return a.Expires.Before(b.Expires)
+			}
+		case "CREATEDATE":
+			if !a.Created.Equal(b.Created) {
+				return a.Created.Before(b.Created)
+			}
+		}
+		return a.Name < b.Name
+	})
+
+	result := domainGetListResult{Domains: []domainListItem{}}
+	for i := (page - 1) * pageSize; i < page*pageSize && i < len(domains); i++ {
+		domain := domains[i]
+		result.Domains = append(result.Domains, domainListItem{
+			ID:         domain.id,
+			Name:       domain.Name,
+			User:       s.credentials.UserName,
+			Created:    domain.Created.Format(dateLayout),
+			Expires:    domain.Expires.Format(dateLayout),
+			IsExpired:  domain.Expires.Before(now),
+			IsLocked:   domain.Locked,
+			AutoRenew:  domain.AutoRenew,
+			WhoisGuard: "NOTPRESENT",
+			IsPremium:  domain.IsPremium,
+			IsOurDNS:   len(domain.Nameservers) == 0,
+		})
+	}
+
+	return []interface{}{result, paging{TotalItems: len(domains), CurrentPage: page, PageSize: pageSize}}, nil
+}
+
+type domainGetInfoResult struct {
+	XMLName       xml.Name `xml:"DomainGetInfoResult"`
+	Status        string   `xml:"Status,attr"`
+	ID            int      `xml:"ID,attr"`
+	DomainName    string   `xml:"DomainName,attr"`
+	OwnerName     string   `xml:"OwnerName,attr"`
+	IsOwner       bool     `xml:"IsOwner,attr"`
+	IsPremium     bool     `xml:"IsPremium,attr"`
+	DomainDetails struct {
+		CreatedDate string `xml:"CreatedDate"`
+		ExpiredDate string `xml:"ExpiredDate"`
+		NumYears    int    `xml:"NumYears"`
+	} `xml:"DomainDetails"`
+	Whoisguard struct {
+		Enabled string `xml:"Enabled,attr"`
+	} `xml:"Whoisguard"`
+	DnsDetails struct { // nolint: stylecheck,revive
+		ProviderType  string   `xml:"ProviderType,attr"`
+		IsUsingOurDNS bool     `xml:"IsUsingOurDNS,attr"`
+		HostCount     int      `xml:"HostCount,attr"`
+		EmailType     string   `xml:"EmailType,attr"`
+		Nameservers   []string `xml:"Nameserver"`
+	} `xml:"DnsDetails"`
+	Modificationrights struct {
+		All bool `xml:"All,attr"`
+	} `xml:"Modificationrights"`
+}
+
+func (s *Server) domainsGetInfo(params url.Values) (interface{}, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := domainGetInfoResult{
+		Status:     "Ok",
+		ID:         domain.id,
+		DomainName: domain.Name,
+		OwnerName:  s.credentials.UserName,
+		IsOwner:    true,
+		IsPremium:  domain.IsPremium,
+	}
+	if domain.Expires.Before(s.now()) {
+		result.Status = "Expired"
+	}
+
+	result.DomainDetails.CreatedDate = domain.Created.Format(dateLayout)
+	result.DomainDetails.ExpiredDate = domain.Expires.Format(dateLayout)
+	result.DomainDetails.NumYears = domain.Expires.Year() - domain.Created.Year()
+	result.Whoisguard.Enabled = "NotAlloted"
+	result.Modificationrights.All = true
+
+	dns := &result.DnsDetails
+	dns.IsUsingOurDNS = len(domain.Nameservers) == 0
+	dns.HostCount = len(domain.Hosts)
+	dns.EmailType = domain.EmailType
+	if dns.IsUsingOurDNS {
+		dns.ProviderType = "FREE"
+		dns.Nameservers = defaultNameservers
+	} else {
+		dns.ProviderType = "CUSTOM"
+		dns.Nameservers = domain.Nameservers
+	}
+
+	return result, nil
+}
+
+type domainCheckResult struct {
+	XMLName                  xml.Name `xml:"DomainCheckResult"`
+	Domain                   string   `xml:"Domain,attr"`
+	Available                bool     `xml:"Available,attr"`
+	ErrorNo                  string   `xml:"ErrorNo,attr"`
+	Description              string   `xml:"Description,attr"`
+	IsPremiumName            bool     `xml:"IsPremiumName,attr"`
+	PremiumRegistrationPrice string   `xml:"PremiumRegistrationPrice,attr"`
+	PremiumRenewalPrice      string   `xml:"PremiumRenewalPrice,attr"`
+	PremiumRestorePrice      string   `xml:"PremiumRestorePrice,attr"`
+	PremiumTransferPrice     string   `xml:"PremiumTransferPrice,attr"`
+	IcannFee                 string   `xml:"IcannFee,attr"`
+	EapFee                   string   `xml:"EapFee,attr"`
+}
+
+func (s *Server) domainsCheck(params url.Values) (interface{}, *Error) {
+	if params.Get("DomainList") == "" {
+		return nil, invalidParameter("DomainList is missing")
+	}
+
+	var results []interface{}
+	for _, name := range strings.Split(params.Get("DomainList"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		tld := name[strings.Index(name, ".")+1:]
+		if _, ok := s.prices[priceKey{action: namecheap.ActionNameRegister, tld: tld}]; !ok {
+			return nil, &Error{Number: errorNumberTLDNotSupported, Message: fmt.Sprintf("TLD is not supported in API: %s", tld)}
+		}
+
+		_, registered := s.domains[name]
+		results = append(results, domainCheckResult{
+			Domain:                   name,
+			Available:                !registered && !s.unavailable[name],
+			ErrorNo:                  "0",
+			PremiumRegistrationPrice: "0",
+			PremiumRenewalPrice:      "0",
+			PremiumRestorePrice:      "0",
+			PremiumTransferPrice:     "0",
+			IcannFee:                 "0",
+			EapFee:                   "0",
+		})
+	}
+
+	return results, nil
+}
+
+type domainCreateResult struct {
+	XMLName           xml.Name `xml:"DomainCreateResult"`
+	Domain            string   `xml:"Domain,attr"`
+	Registered        bool     `xml:"Registered,attr"`
+	ChargedAmount     string   `xml:"ChargedAmount,attr"`
+	DomainID          int      `xml:"DomainID,attr"`
+	OrderID           int      `xml:"OrderID,attr"`
+	TransactionID     int      `xml:"TransactionID,attr"`
+	WhoisguardEnable  bool     `xml:"WhoisguardEnable,attr"`
+	NonRealTimeDomain bool     `xml:"NonRealTimeDomain,attr"`
+}
+
+func (s *Server) domainsCreate(params url.Values) (interface{}, *Error) {
+	name := strings.ToLower(params.Get("DomainName"))
+	if name == "" {
+		return nil, invalidParameter("DomainName is missing")
+	}
+
+	years, err := strconv.Atoi(params.Get("Years"))
+	if err != nil || years < 1 {
+		return nil, invalidParameter("Years is invalid")
+	}
+
+	if _, registered := s.domains[name]; registered || s.unavailable[name] {
+		return nil, &Error{Number: errorNumberDomainNotAvailable, Message: fmt.Sprintf("Domain not available: %s", name)}
+	}
+
+	amount, apiErr := s.charge(namecheap.ActionNameRegister, name, years)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	created := s.now()
+	domain := Domain{Name: name, Created: created, Expires: created.AddDate(years, 0, 0)}
+	if nameservers := params.Get("Nameservers"); nameservers != "" {
+		domain.Nameservers = strings.Split(nameservers, ",")
+	}
+	setContacts(&domain.Contacts, params)
+
+	added := s.addDomain(domain)
+
+	return domainCreateResult{
+		Domain:        name,
+		Registered:    true,
+		ChargedAmount: formatAmount(amount),
+		DomainID:      added.id,
+		OrderID:       s.newID(),
+		TransactionID: s.newID(),
+	}, nil
+}
+
+type domainRenewResult struct {
+	XMLName       xml.Name `xml:"DomainRenewResult"`
+	DomainName    string   `xml:"DomainName,attr"`
+	DomainID      int      `xml:"DomainID,attr"`
+	Renew         bool     `xml:"Renew,attr"`
+	OrderID       int      `xml:"OrderID,attr"`
+	TransactionID int      `xml:"TransactionID,attr"`
+	ChargedAmount string   `xml:"ChargedAmount,attr"`
+	DomainDetails struct {
+		ExpiredDate string `xml:"ExpiredDate"`
+		NumYears    int    `xml:"NumYears"`
+	} `xml:"DomainDetails"`
+}
+
+func (s *Server) domainsRenew(params url.Values) (interface{}, *Error) {
+	domain, apiErr := s.domain(params)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	years, err := strconv.Atoi(params.Get("Years"))
+	if err != nil || years < 1 {
+		return nil, invalidParameter("Years is invalid")
+	}
+
+	amount, apiErr := s.charge(namecheap.ActionNameRenew, domain.Name, years)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	domain.Expires = domain.Expires.AddDate(years, 0, 0)
+
+	result := domainRenewResult{
+		DomainName:    domain.Name,
+		DomainID:      domain.id,
+		Renew:         true,
+		OrderID:       s.newID(),
+		TransactionID: s.newID(),
+		ChargedAmount: formatAmount(amount),
+	}
+	result.DomainDetails.ExpiredDate = domain.Expires.Format(dateLayout)
+	result.DomainDetails.NumYears = years
+
+	return result, nil
+}
+
+type domainContactsResult struct {
+	XMLName      xml.Name      `xml:"DomainContactsResult"`
+	Domain       string        `xml:"Domain,attr"`
+	DomainNameID int           `xml:"domainnameid,attr"`
+	Registrant   domainContact `xml:"Registrant"`
+	Tech         domainContact `xml:"Tech"`
+	Admin        domainContact `xml:"Admin"`
+	AuxBilling   domainContact `xml:"AuxBilling"`
+}
+
+type domainContact struct {
+	ReadOnly bool `xml:"ReadOnly,attr"`
+	Contact
+}
+
+func (s *Server) domainsGetContacts(params url.Values) (interface{}, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainContactsResult{
+		Domain:       domain.Name,
+		DomainNameID: domain.id,
+		Registrant:   domainContact{Contact: domain.Contacts.Registrant},
+		Tech:         domainContact{Contact: domain.Contacts.Tech},
+		Admin:        domainContact{Contact: domain.Contacts.Admin},
+		AuxBilling:   domainContact{Contact: domain.Contacts.AuxBilling},
+	}, nil
+}
+
+type domainSetContactResult struct {
+	XMLName   xml.Name `xml:"DomainSetContactResult"`
+	Domain    string   `xml:"Domain,attr"`
+	IsSuccess bool     `xml:"IsSuccess,attr"`
+}
+
+func (s *Server) domainsSetContacts(params url.Values) (interface{}, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	for prefix := range domain.Contacts.byPrefix() {
+		if params.Get(prefix+"FirstName") == "" {
+			return nil, invalidParameter("Parameter %sFirstName is missing", prefix)
+		}
+	}
+
+	setContacts(&domain.Contacts, params)
+
+	return domainSetContactResult{Domain: domain.Name, IsSuccess: true}, nil
+}
+
+// setContacts replaces the contacts with the prefixed contact parameters
+func setContacts(contacts *Contacts, params url.Values) {
+	for prefix, contact := range contacts.byPrefix() {
+		if params.Get(prefix+"FirstName") == "" {
+			continue
+		}
+		*contact = Contact{}
+		for name, value := range contact.fields() {
+			*value = params.Get(prefix + name)
+		}
+	}
+}
+
+type domainGetRegistrarLockResult struct {
+	XMLName             xml.Name `xml:"DomainGetRegistrarLockResult"`
+	Domain              string   `xml:"Domain,attr"`
+	RegistrarLockStatus bool     `xml:"RegistrarLockStatus,attr"`
+}
+
+func (s *Server) domainsGetRegistrarLock(params url.Values) (interface{}, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainGetRegistrarLockResult{Domain: domain.Name, RegistrarLockStatus: domain.Locked}, nil
+}
+
+type domainSetRegistrarLockResult struct {
+	XMLName   xml.Name `xml:"DomainSetRegistrarLockResult"`
+	Domain    string   `xml:"Domain,attr"`
+	IsSuccess bool     `xml:"IsSuccess,attr"`
+}
+
+func (s *Server) domainsSetRegistrarLock(params url.Values) (interface{}, *Error) {
+	domain, err := s.domain(params)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToUpper(params.Get("LockAction")) {
+	case "", "LOCK":
+		domain.Locked = true
+	case "UNLOCK":
+		domain.Locked = false
+	default:
+		return nil, invalidParameter("LockAction is invalid")
+	}
+
+	return domainSetRegistrarLockResult{Domain: domain.Name, IsSuccess: true}, nil
+}
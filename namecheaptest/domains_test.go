@@ -0,0 +1,217 @@
+package namecheaptest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func testContact() *namecheap.ContactInfo {
+	return &namecheap.ContactInfo{
+		FirstName:     namecheap.String("John"),
+		LastName:      namecheap.String("Smith"),
+		Address1:      namecheap.String("8939 S. cross Blvd"),
+		City:          namecheap.String("california"),
+		StateProvince: namecheap.String("ca"),
+		PostalCode:    namecheap.String("90045"),
+		Country:       namecheap.String("US"),
+		Phone:         namecheap.String("+1.6613102107"),
+		EmailAddress:  namecheap.String("john@gmail.com"),
+	}
+}
+
+func TestDomains(t *testing.T) {
+	t.Run("get_list", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		now := time.Now()
+		for _, name := range []string{"c.com", "a.com", "b.net"} {
+			server.AddDomain(Domain{Name: name})
+		}
+		server.AddDomain(Domain{Name: "expiring.com", Expires: now.Add(10 * 24 * time.Hour)})
+		server.AddDomain(Domain{Name: "expired.com", Created: now.AddDate(-2, 0, 0), Expires: now.AddDate(-1, 0, 0)})
+
+		client := server.Client()
+
+		response, err := client.Domains.GetList(&namecheap.DomainsGetListArgs{PageSize: namecheap.Int(10)})
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+		assert.Equal(t, 5, *response.Paging.TotalItems)
+		assert.Equal(t, "a.com", *(*response.Domains)[0].Name)
+		assert.True(t, *(*response.Domains)[0].IsOurDNS)
+
+		response, err = client.Domains.GetList(&namecheap.DomainsGetListArgs{ListType: namecheap.String("EXPIRING")})
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+		assert.Len(t, *response.Domains, 1)
+		assert.Equal(t, "expiring.com", *(*response.Domains)[0].Name)
+
+		response, err = client.Domains.GetList(&namecheap.DomainsGetListArgs{ListType: namecheap.String("EXPIRED")})
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+		assert.Len(t, *response.Domains, 1)
+		assert.True(t, *(*response.Domains)[0].IsExpired)
+
+		response, err = client.Domains.GetList(&namecheap.DomainsGetListArgs{SearchTerm: namecheap.String(".net"), SortBy: namecheap.String("NAME_DESC")})
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+		assert.Len(t, *response.Domains, 1)
+	})
+
+	t.Run("pager", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		for i := 0; i < 25; i++ {
+			server.AddDomain(Domain{Name: string(rune('a'+i)) + "domain.com"})
+		}
+
+		pager := server.Client().Domains.NewGetListPager(&namecheap.DomainsGetListArgs{PageSize: namecheap.Int(10)})
+		pager.Prefetch = 2
+
+		domains, err := pager.All(context.Background())
+		if err != nil {
+			t.Fatal("Unable to get domains", err)
+		}
+		assert.Len(t, domains, 25)
+		assert.Equal(t, "adomain.com", *domains[0].Name)
+		assert.Equal(t, "ydomain.com", *domains[24].Name)
+	})
+
+	t.Run("get_info", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.AddDomain(Domain{Name: "domain.com", Nameservers: []string{"ns1.other.net", "ns2.other.net"}})
+		client := server.Client()
+
+		response, err := client.Domains.GetInfo("domain.com")
+		if err != nil {
+			t.Fatal("Unable to get domain info", err)
+		}
+		assert.Equal(t, "domain.com", *response.DomainDNSGetListResult.DomainName)
+		assert.Equal(t, "CUSTOM", *response.DomainDNSGetListResult.DnsDetails.ProviderType)
+		assert.Equal(t, []string{"ns1.other.net", "ns2.other.net"}, *response.DomainDNSGetListResult.DnsDetails.Nameservers)
+
+		_, err = client.Domains.GetInfo("missing.com")
+		assert.True(t, namecheap.IsDomainNotFound(err))
+	})
+
+	t.Run("check_and_create", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.SetUnavailable("google.com")
+		server.SetBalance(25)
+		client := server.Client()
+
+		response, err := client.Domains.Check([]string{"google.com", "mine.com"})
+		if err != nil {
+			t.Fatal("Unable to check domains", err)
+		}
+		assert.Equal(t, "false", *(*response.DomainCheckResults)[0].Available)
+		assert.Equal(t, "true", *(*response.DomainCheckResults)[1].Available)
+
+		args := &namecheap.CreateArgs{
+			DomainName: namecheap.String("mine.com"),
+			Years:      namecheap.Int(2),
+			Registrant: testContact(),
+			Tech:       testContact(),
+			Admin:      testContact(),
+			AuxBilling: testContact(),
+		}
+
+		created, err := client.Domains.Create(args)
+		if err != nil {
+			t.Fatal("Unable to create domain", err)
+		}
+		assert.True(t, *created.DomainCreateResult.Registered)
+		assert.Equal(t, "20.56", *created.DomainCreateResult.ChargedAmount)
+		assert.InDelta(t, 4.44, server.Balance(), 0.001)
+
+		domain, ok := server.Domain("mine.com")
+		assert.True(t, ok)
+		assert.Equal(t, "John", domain.Contacts.Registrant.FirstName)
+		assert.Equal(t, domain.Created.AddDate(2, 0, 0), domain.Expires)
+
+		_, err = client.Domains.Create(args)
+		assert.True(t, namecheap.IsAPIErrorNumber(err, "3019166"))
+
+		args.DomainName = namecheap.String("other.com")
+		_, err = client.Domains.Create(args)
+		assert.True(t, namecheap.IsAPIErrorNumber(err, "2528166"))
+
+		args.DomainName = namecheap.String("other.xyz")
+		_, err = client.Domains.Create(args)
+		assert.True(t, namecheap.IsAPIErrorNumber(err, "2030280"))
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		server.AddDomain(Domain{Name: "domain.com", Expires: expires})
+
+		response, err := server.Client().Domains.Renew("domain.com", &namecheap.RenewArgs{Years: namecheap.Int(1)})
+		if err != nil {
+			t.Fatal("Unable to renew domain", err)
+		}
+		assert.True(t, *response.DomainRenewResult.Renew)
+		assert.Equal(t, "01/01/2031", *response.DomainRenewResult.DomainDetails.ExpiredDate)
+	})
+
+	t.Run("contacts", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.AddDomain(Domain{Name: "domain.com", Contacts: Contacts{Registrant: Contact{FirstName: "Jane"}}})
+		client := server.Client()
+
+		contacts, err := client.Domains.GetContacts("domain.com")
+		if err != nil {
+			t.Fatal("Unable to get contacts", err)
+		}
+		assert.Equal(t, "Jane", *contacts.DomainContactsResult.Registrant.FirstName)
+
+		_, err = client.Domains.SetContacts("domain.com", &namecheap.SetContactsArgs{
+			Registrant: testContact(),
+			Tech:       testContact(),
+			Admin:      testContact(),
+			AuxBilling: testContact(),
+		})
+		if err != nil {
+			t.Fatal("Unable to set contacts", err)
+		}
+
+		domain, _ := server.Domain("domain.com")
+		assert.Equal(t, "John", domain.Contacts.Registrant.FirstName)
+		assert.Equal(t, "90045", domain.Contacts.AuxBilling.PostalCode)
+	})
+
+	t.Run("registrar_lock", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.AddDomain(Domain{Name: "domain.com"})
+		client := server.Client()
+
+		_, err := client.Domains.SetRegistrarLock("domain.com", nil)
+		assert.Nil(t, err)
+
+		response, err := client.Domains.GetRegistrarLock("domain.com")
+		if err != nil {
+			t.Fatal("Unable to get registrar lock", err)
+		}
+		assert.True(t, *response.Result.RegistrarLockStatus)
+	})
+}
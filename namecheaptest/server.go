@@ -0,0 +1,386 @@
+// Package namecheaptest provides an in-memory fake of the Namecheap XML API for integration tests.
+//
+// The fake keeps domains, host records, nameservers, contacts, the account balance and the pricing
+// in memory and answers the commands with the XML envelopes and error numbers of the real API:
+//
+//	server := namecheaptest.NewServer()
+//	defer server.Close()
+//
+//	server.AddDomain(namecheaptest.Domain{Name: "domain.com"})
+//	client := server.Client()
+//
+//	response, err := client.DomainsDNS.GetHosts("domain.com")
+//
+// Rate limiting and failures can be simulated with ThrottleNext, SetRateLimit, FailNext and AddHook.
+//
+// Responses of the real API can be recorded into cassettes by a Recorder and served back offline by a Replayer.
+package namecheaptest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+const (
+	DefaultUserName = "namecheaptest"
+	DefaultAPIUser  = "namecheaptest"
+	DefaultAPIKey   = "namecheaptest-api-key"
+	DefaultClientIP = "127.0.0.1"
+
+	// DefaultBalance is the initial available balance of the account in USD
+	DefaultBalance = 1000.0
+
+	// ErrorNumberUnknownCommand is reported for the commands the fake doesn't implement
+	ErrorNumberUnknownCommand = "1010900"
+)
+
+// Error is an error reported in the Errors block of the response
+type Error struct {
+	Number  string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (%s)", e.Message, e.Number)
+}
+
+// Request is a request received by the server
+type Request struct {
+	Command string
+	Params  url.Values
+}
+
+// Fault replaces or delays the response to a request, see AddHook and FailNext
+type Fault struct {
+	// Delay is waited before responding
+	Delay time.Duration
+	// CloseConnection drops the connection without a response, like a network failure
+	CloseConnection bool
+	// StatusCode is the HTTP status of the response, e.g. 405 for throttling or 500.
+	// The body is empty unless Error is set.
+	StatusCode int
+	// Error is reported in the Errors block of the response
+	Error *Error
+}
+
+// Hook is called for every request before it is handled, a non-nil Fault changes the response.
+// Hooks are called concurrently and may use the methods of the server.
+type Hook func(command string, params url.Values) *Fault
+
+// Server is a fake Namecheap API server, see NewServer
+type Server struct {
+	// URL is the base URL of the server, it is passed to namecheap.WithBaseURL
+	URL string
+
+	server      *httptest.Server
+	credentials namecheap.ClientOptions
+	now         func() time.Time
+
+	mu           sync.Mutex
+	domains      map[string]*Domain
+	unavailable  map[string]bool
+	balance      float64
+	prices       map[priceKey]float64
+	nextID       int
+	requests     []Request
+	hooks        []Hook
+	throttleNext int
+	rateLimit    int
+	rateInterval time.Duration
+	requestTimes []time.Time
+}
+
+// NewServer starts a fake Namecheap API server accepting the default credentials.
+// It must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		credentials: namecheap.ClientOptions{
+			UserName: DefaultUserName,
+			ApiUser:  DefaultAPIUser,
+			ApiKey:   DefaultAPIKey,
+			ClientIp: DefaultClientIP,
+		},
+		now:         time.Now,
+		domains:     map[string]*Domain{},
+		unavailable: map[string]bool{},
+		balance:     DefaultBalance,
+		prices:      defaultPrices(),
+		nextID:      1000,
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// ClientOptions returns the credentials accepted by the server
+func (s *Server) ClientOptions() *namecheap.ClientOptions {
+	options := s.credentials
+	return &options
+}
+
+// Client returns a client sending its requests to the server.
+// Throttled requests are retried without delay, opts can override it.
+func (s *Server) Client(opts ...namecheap.Option) *namecheap.Client {
+	defaults := []namecheap.Option{
+		namecheap.WithBaseURL(s.URL),
+		namecheap.WithRetryPolicy(&namecheap.FixedDelays{Delays: make([]time.Duration, 5)}),
+	}
+
+	return namecheap.NewClient(s.ClientOptions(), append(defaults, opts...)...)
+}
+
+// Requests returns the requests received so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request{}, s.requests...)
+}
+
+// AddHook registers a hook called for every request
+func (s *Server) AddHook(hook Hook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.hooks = append(s.hooks, hook)
+}
+
+// FailNext applies the fault to the next request of the command, or of any command when command is empty
+func (s *Server) FailNext(command string, fault Fault) {
+	var mu sync.Mutex
+	used := false
+
+	s.AddHook(func(requested string, _ url.Values) *Fault {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if used || (command != "" && !strings.EqualFold(command, requested)) {
+			return nil
+		}
+		used = true
+
+		return &fault
+	})
+}
+
+// ThrottleNext rejects the next n requests with the 405 status the API uses for throttling
+func (s *Server) ThrottleNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.throttleNext = n
+}
+
+// SetRateLimit rejects the requests with the 405 status once more than requests were received within interval.
+// Zero requests disables the limit.
+func (s *Server) SetRateLimit(requests int, interval time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimit = requests
+	s.rateInterval = interval
+	s.requestTimes = nil
+}
+
+type commandHandler func(s *Server, params url.Values) (interface{}, *Error)
+
+var commandHandlers = map[string]commandHandler{
+	"namecheap.domains.getlist":          (*Server).domainsGetList,
+	"namecheap.domains.getinfo":          (*Server).domainsGetInfo,
+	"namecheap.domains.check":            (*Server).domainsCheck,
+	"namecheap.domains.create":           (*Server).domainsCreate,
+	"namecheap.domains.renew":            (*Server).domainsRenew,
+	"namecheap.domains.getcontacts":      (*Server).domainsGetContacts,
+	"namecheap.domains.setcontacts":      (*Server).domainsSetContacts,
+	"namecheap.domains.getregistrarlock": (*Server).domainsGetRegistrarLock,
+	"namecheap.domains.setregistrarlock": (*Server).domainsSetRegistrarLock,
+	"namecheap.domains.dns.gethosts":     (*Server).dnsGetHosts,
+	"namecheap.domains.dns.sethosts":     (*Server).dnsSetHosts,
+	"namecheap.domains.dns.getlist":      (*Server).dnsGetList,
+	"namecheap.domains.dns.setcustom":    (*Server).dnsSetCustom,
+	"namecheap.domains.dns.setdefault":   (*Server).dnsSetDefault,
+	"namecheap.users.getbalances":        (*Server).usersGetBalances,
+	"namecheap.users.getpricing":         (*Server).usersGetPricing,
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	command := r.Form.Get("Command")
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Command: command, Params: r.Form})
+	hooks := append([]Hook{}, s.hooks...)
+	s.mu.Unlock()
+
+	for _, hook := range hooks {
+		fault := hook(command, r.Form)
+		if fault == nil {
+			continue
+		}
+
+		if fault.Delay > 0 {
+			time.Sleep(fault.Delay)
+		}
+
+		if fault.CloseConnection {
+			if hijacker, ok := w.(http.Hijacker); ok {
+				if conn, _, err := hijacker.Hijack(); err == nil {
+					_ = conn.Close()
+					return
+				}
+			}
+		}
+
+		if fault.StatusCode != 0 && fault.Error == nil {
+			w.WriteHeader(fault.StatusCode)
+			return
+		}
+
+		if fault.Error != nil {
+			writeResponse(w, fault.StatusCode, command, nil, fault.Error)
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.throttled() {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := s.authenticate(r.Form); err != nil {
+		writeResponse(w, http.StatusOK, command, nil, err)
+		return
+	}
+
+	handler, ok := commandHandlers[strings.ToLower(command)]
+	if !ok {
+		writeResponse(w, http.StatusOK, command, nil, &Error{Number: ErrorNumberUnknownCommand, Message: fmt.Sprintf("Invalid command: %s", command)})
+		return
+	}
+
+	result, apiErr := handler(s, r.Form)
+	writeResponse(w, http.StatusOK, command, result, apiErr)
+}
+
+// throttled reports whether the request exceeds ThrottleNext or SetRateLimit
+func (s *Server) throttled() bool {
+	if s.throttleNext > 0 {
+		s.throttleNext--
+		return true
+	}
+
+	if s.rateLimit <= 0 {
+		return false
+	}
+
+	now := s.now()
+	recent := s.requestTimes[:0]
+	for _, t := range s.requestTimes {
+		if now.Sub(t) < s.rateInterval {
+			recent = append(recent, t)
+		}
+	}
+	s.requestTimes = recent
+
+	if len(s.requestTimes) >= s.rateLimit {
+		return true
+	}
+
+	s.requestTimes = append(s.requestTimes, now)
+
+	return false
+}
+
+func (s *Server) authenticate(params url.Values) *Error {
+	switch {
+	case params.Get("ApiUser") != s.credentials.ApiUser:
+		return &Error{Number: namecheap.ErrorNumberAPIUserInvalid, Message: "Parameter APIUser is invalid"}
+	case params.Get("ApiKey") != s.credentials.ApiKey:
+		return &Error{Number: namecheap.ErrorNumberAPIKeyInvalid, Message: "Parameter APIKey is invalid"}
+	case params.Get("ClientIp") != s.credentials.ClientIp:
+		return &Error{Number: namecheap.ErrorNumberRequestIPInvalid, Message: fmt.Sprintf("Invalid request IP: %s", params.Get("ClientIp"))}
+	case params.Get("Username") != s.credentials.UserName:
+		return &Error{Number: namecheap.ErrorNumberUserNameUnauthorized, Message: "UserName is not authorized"}
+	}
+
+	return nil
+}
+
+type apiResponse struct {
+	XMLName           xml.Name         `xml:"http://api.namecheap.com/xml.response ApiResponse"`
+	Status            string           `xml:"Status,attr"`
+	Errors            []apiError       `xml:"Errors>Error"`
+	Warnings          struct{}         `xml:"Warnings"`
+	RequestedCommand  string           `xml:"RequestedCommand"`
+	CommandResponse   *commandResponse `xml:"CommandResponse,omitempty"`
+	Server            string           `xml:"Server"`
+	GMTTimeDifference string           `xml:"GMTTimeDifference"`
+	ExecutionTime     string           `xml:"ExecutionTime"`
+}
+
+type apiError struct {
+	Number  string `xml:"Number,attr"`
+	Message string `xml:",chardata"`
+}
+
+type commandResponse struct {
+	Type    string `xml:"Type,attr"`
+	Content []interface{}
+}
+
+func writeResponse(w http.ResponseWriter, statusCode int, command string, result interface{}, apiErr *Error) {
+	response := apiResponse{
+		Status:            "OK",
+		RequestedCommand:  strings.ToLower(command),
+		Server:            "NAMECHEAPTEST",
+		GMTTimeDifference: "--5:00",
+		ExecutionTime:     "0.001",
+	}
+
+	if apiErr != nil {
+		response.Status = "ERROR"
+		response.Errors = []apiError{{Number: apiErr.Number, Message: apiErr.Message}}
+	} else if result != nil {
+		content, ok := result.([]interface{})
+		if !ok {
+			content = []interface{}{result}
+		}
+		response.CommandResponse = &commandResponse{Type: command, Content: content}
+	}
+
+	body, err := xml.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	w.WriteHeader(statusCode)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,143 @@
+package namecheaptest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func TestServer(t *testing.T) {
+	t.Run("authentication", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		options := server.ClientOptions()
+		options.ApiKey = "wrong"
+		client := namecheap.NewClient(options, namecheap.WithBaseURL(server.URL))
+
+		_, err := client.Users.GetBalances()
+		assert.True(t, namecheap.IsAuthError(err))
+
+		options = server.ClientOptions()
+		options.ClientIp = "10.0.0.1"
+		client = namecheap.NewClient(options, namecheap.WithBaseURL(server.URL))
+
+		_, err = client.Users.GetBalances()
+		assert.True(t, namecheap.IsAPIErrorNumber(err, namecheap.ErrorNumberRequestIPInvalid))
+	})
+
+	t.Run("unknown_command", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		_, err := server.Client().SSL.GetList(nil)
+		assert.True(t, namecheap.IsAPIErrorNumber(err, ErrorNumberUnknownCommand))
+	})
+
+	t.Run("requests", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		_, err := server.Client().Users.GetBalances()
+		assert.Nil(t, err)
+
+		requests := server.Requests()
+		assert.Len(t, requests, 1)
+		assert.Equal(t, "namecheap.users.getBalances", requests[0].Command)
+		assert.Equal(t, DefaultAPIUser, requests[0].Params.Get("ApiUser"))
+	})
+
+	t.Run("throttle_next", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.ThrottleNext(2)
+
+		_, err := server.Client().Users.GetBalances()
+		assert.Nil(t, err)
+		assert.Len(t, server.Requests(), 3)
+
+		server.ThrottleNext(10)
+
+		_, err = server.Client(namecheap.WithRetryPolicy(nil)).Users.GetBalances()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("rate_limit", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		server.now = func() time.Time { return now }
+		server.SetRateLimit(2, time.Minute)
+
+		client := server.Client(namecheap.WithRetryPolicy(nil))
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Users.GetBalances()
+			assert.Nil(t, err)
+		}
+
+		_, err := client.Users.GetBalances()
+		assert.NotNil(t, err)
+
+		now = now.Add(time.Minute)
+
+		_, err = client.Users.GetBalances()
+		assert.Nil(t, err)
+	})
+
+	t.Run("fail_next", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.FailNext("namecheap.users.getBalances", Fault{Error: &Error{Number: namecheap.ErrorNumberTooManyRequests, Message: "Too many requests"}})
+		server.FailNext("namecheap.users.getBalances", Fault{StatusCode: http.StatusInternalServerError})
+
+		client := server.Client()
+
+		_, err := client.Users.GetBalances()
+		assert.Nil(t, err)
+		assert.Len(t, server.Requests(), 3)
+
+		server.FailNext("", Fault{StatusCode: http.StatusInternalServerError})
+
+		_, err = client.Domains.Create(&namecheap.CreateArgs{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("close_connection", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.FailNext("", Fault{CloseConnection: true})
+
+		_, err := server.Client(namecheap.WithRetryPolicy(nil)).Users.GetBalances()
+		assert.NotNil(t, err)
+
+		var apiErr *namecheap.APIError
+		assert.False(t, errors.As(err, &apiErr))
+	})
+
+	t.Run("delay", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.AddHook(func(command string, _ url.Values) *Fault {
+			return &Fault{Delay: 200 * time.Millisecond}
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := server.Client().Users.GetBalancesContext(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,201 @@
+package namecheaptest
+
+import (
+	"strings"
+	"time"
+)
+
+// Domain is a domain registered in the fake account
+type Domain struct {
+	Name      string
+	Created   time.Time
+	Expires   time.Time
+	AutoRenew bool
+	Locked    bool
+	IsPremium bool
+	// Nameservers are the custom nameservers of the domain, it uses the Namecheap DNS when empty
+	Nameservers []string
+	// EmailType is one of the namecheap.EmailType... values. Default value: NONE
+	EmailType string
+	Hosts     []Host
+	Contacts  Contacts
+
+	id int
+}
+
+// Host is a host record of a domain
+type Host struct {
+	// ID is assigned by the server
+	ID      int
+	Name    string
+	Type    string
+	Address string
+	MXPref  int
+	TTL     int
+}
+
+// Contacts are the contacts of a domain
+type Contacts struct {
+	Registrant Contact
+	Tech       Contact
+	Admin      Contact
+	AuxBilling Contact
+}
+
+// Contact is a single contact of a domain
+type Contact struct {
+	OrganizationName    string
+	JobTitle            string
+	FirstName           string
+	LastName            string
+	Address1            string
+	Address2            string
+	City                string
+	StateProvince       string
+	StateProvinceChoice string
+	PostalCode          string
+	Country             string
+	Phone               string
+	PhoneExt            string
+	Fax                 string
+	EmailAddress        string
+}
+
+// fields maps the request parameter names of the contact to its fields
+func (c *Contact) fields() map[string]*string {
+	return map[string]*string{
+		"OrganizationName":    &c.OrganizationName,
+		"JobTitle":            &c.JobTitle,
+		"FirstName":           &c.FirstName,
+		"LastName":            &c.LastName,
+		"Address1":            &c.Address1,
+		"Address2":            &c.Address2,
+		"City":                &c.City,
+		"StateProvince":       &c.StateProvince,
+		"StateProvinceChoice": &c.StateProvinceChoice,
+		"PostalCode":          &c.PostalCode,
+		"Country":             &c.Country,
+		"Phone":               &c.Phone,
+		"PhoneExt":            &c.PhoneExt,
+		"Fax":                 &c.Fax,
+		"EmailAddress":        &c.EmailAddress,
+	}
+}
+
+func (c *Contacts) byPrefix() map[string]*Contact {
+	return map[string]*Contact{
+		"Registrant": &c.Registrant,
+		"Tech":       &c.Tech,
+		"Admin":      &c.Admin,
+		"AuxBilling": &c.AuxBilling,
+	}
+}
+
+func (d *Domain) copy() Domain {
+	copied := *d
+	copied.Nameservers = append([]string(nil), d.Nameservers...)
+	copied.Hosts = append([]Host(nil), d.Hosts...)
+	return copied
+}
+
+func (d *Domain) tld() string {
+	return d.Name[strings.Index(d.Name, ".")+1:]
+}
+
+// AddDomain adds the domain to the account, replacing the domain with the same name.
+// Created defaults to now, Expires to a year after Created and EmailType to NONE.
+func (s *Server) AddDomain(domain Domain) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addDomain(domain)
+}
+
+func (s *Server) addDomain(domain Domain) *Domain {
+	added := domain.copy()
+	added.Name = strings.ToLower(domain.Name)
+	added.id = s.newID()
+
+	if added.Created.IsZero() {
+		added.Created = s.now()
+	}
+	if added.Expires.IsZero() {
+		added.Expires = added.Created.AddDate(1, 0, 0)
+	}
+	if added.EmailType == "" {
+		added.EmailType = "NONE"
+	}
+	for i := range added.Hosts {
+		added.Hosts[i] = s.normalizeHost(added.Hosts[i])
+	}
+
+	s.domains[added.Name] = &added
+
+	return &added
+}
+
+// Domain returns a copy of the domain in its current state
+func (s *Server) Domain(name string) (Domain, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	domain, ok := s.domains[strings.ToLower(name)]
+	if !ok {
+		return Domain{}, false
+	}
+
+	return domain.copy(), true
+}
+
+// SetUnavailable makes namecheap.domains.check report the domains as registered by somebody else
+func (s *Server) SetUnavailable(names ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range names {
+		s.unavailable[strings.ToLower(name)] = true
+	}
+}
+
+// Balance returns the available balance of the account in USD
+func (s *Server) Balance() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.balance
+}
+
+// SetBalance sets the available balance of the account in USD
+func (s *Server) SetBalance(balance float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.balance = balance
+}
+
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+// normalizeHost applies the defaults and the formatting of the API to the host record
+func (s *Server) normalizeHost(host Host) Host {
+	host.ID = s.newID()
+	host.Type = strings.ToUpper(host.Type)
+
+	if host.TTL == 0 {
+		host.TTL = 1800
+	}
+	if host.MXPref == 0 {
+		host.MXPref = 10
+	}
+
+	switch host.Type {
+	case "CNAME", "MX", "NS":
+		if !strings.HasSuffix(host.Address, ".") {
+			host.Address += "."
+		}
+	}
+
+	return host
+}
@@ -0,0 +1,177 @@
+package namecheaptest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// maxPricingYears is the longest registration period listed by namecheap.users.getPricing
+const maxPricingYears = 10
+
+type priceKey struct {
+	action namecheap.ActionName
+	tld    string
+}
+
+func defaultPrices() map[priceKey]float64 {
+	prices := map[priceKey]float64{}
+
+	for tld, price := range map[string]float64{"com": 10.28, "net": 12.98, "org": 9.98, "io": 35.98, "dev": 12.98} {
+		prices[priceKey{action: namecheap.ActionNameRegister, tld: tld}] = price
+		prices[priceKey{action: namecheap.ActionNameRenew, tld: tld}] = price + 4
+		prices[priceKey{action: namecheap.ActionNameTransfer, tld: tld}] = price
+		prices[priceKey{action: namecheap.ActionNameReactivate, tld: tld}] = price + 4
+	}
+
+	return prices
+}
+
+// SetPrice sets the yearly price of the action for the TLD in USD, e.g. namecheap.ActionNameRegister and "com".
+// Registering and checking domains of TLDs without REGISTER price fails as unsupported.
+func (s *Server) SetPrice(action namecheap.ActionName, tld string, price float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prices[priceKey{action: action, tld: strings.ToLower(tld)}] = price
+}
+
+// charge takes the price of the action for the years from the balance
+func (s *Server) charge(action namecheap.ActionName, name string, years int) (float64, *Error) {
+	tld := name[strings.Index(name, ".")+1:]
+
+	price, ok := s.prices[priceKey{action: action, tld: tld}]
+	if !ok {
+		return 0, &Error{Number: errorNumberTLDNotSupported, Message: fmt.Sprintf("TLD is not supported in API: %s", tld)}
+	}
+
+	amount := price * float64(years)
+	if amount > s.balance {
+		return 0, &Error{
+			Number:  errorNumberOrderFailed,
+			Message: fmt.Sprintf("Order creation failed, insufficient funds: %s USD required, %s USD available", formatAmount(amount), formatAmount(s.balance)),
+		}
+	}
+
+	s.balance -= amount
+
+	return amount, nil
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+type userGetBalancesResult struct {
+	XMLName                   xml.Name `xml:"UserGetBalancesResult"`
+	Currency                  string   `xml:"Currency,attr"`
+	AvailableBalance          string   `xml:"AvailableBalance,attr"`
+	AccountBalance            string   `xml:"AccountBalance,attr"`
+	EarnedAmount              string   `xml:"EarnedAmount,attr"`
+	WithdrawableAmount        string   `xml:"WithdrawableAmount,attr"`
+	FundsRequiredForAutoRenew string   `xml:"FundsRequiredForAutoRenew,attr"`
+}
+
+func (s *Server) usersGetBalances(_ url.Values) (interface{}, *Error) {
+	now := s.now()
+
+	autoRenew := 0.0
+	for _, domain := range s.domains {
+		if domain.AutoRenew && !domain.Expires.Before(now) && domain.Expires.Sub(now) <= expiringWithin {
+			autoRenew += s.prices[priceKey{action: namecheap.ActionNameRenew, tld: domain.tld()}]
+		}
+	}
+
+	return userGetBalancesResult{
+		Currency:                  "USD",
+		AvailableBalance:          formatAmount(s.balance),
+		AccountBalance:            formatAmount(s.balance),
+		EarnedAmount:              "0.00",
+		WithdrawableAmount:        "0.00",
+		FundsRequiredForAutoRenew: formatAmount(autoRenew),
+	}, nil
+}
+
+type userGetPricingResult struct {
+	XMLName      xml.Name             `xml:"UserGetPricingResult"`
+	ProductTypes []pricingProductType `xml:"ProductType"`
+}
+
+type pricingProductType struct {
+	Name       string            `xml:"Name,attr"`
+	Categories []pricingCategory `xml:"ProductCategory"`
+}
+
+type pricingCategory struct {
+	Name     string           `xml:"Name,attr"`
+	Products []pricingProduct `xml:"Product"`
+}
+
+type pricingProduct struct {
+	Name   string         `xml:"Name,attr"`
+	Prices []pricingPrice `xml:"Price"`
+}
+
+type pricingPrice struct {
+	Duration     int    `xml:"Duration,attr"`
+	DurationType string `xml:"DurationType,attr"`
+	Price        string `xml:"Price,attr"`
+	RegularPrice string `xml:"RegularPrice,attr"`
+	YourPrice    string `xml:"YourPrice,attr"`
+	CouponPrice  string `xml:"CouponPrice,attr"`
+	Currency     string `xml:"Currency,attr"`
+}
+
+func (s *Server) usersGetPricing(params url.Values) (interface{}, *Error) {
+	if !strings.EqualFold(params.Get("ProductType"), string(namecheap.ProductTypeDomain)) {
+		return nil, invalidParameter("ProductType %s is not supported", params.Get("ProductType"))
+	}
+
+	action := namecheap.ActionName(strings.ToUpper(params.Get("ActionName")))
+	product := strings.ToLower(params.Get("ProductName"))
+
+	categories := map[namecheap.ActionName]*pricingCategory{}
+	for key, price := range s.prices {
+		if (action != "" && key.action != action) || (product != "" && key.tld != product) {
+			continue
+		}
+
+		category, ok := categories[key.action]
+		if !ok {
+			category = &pricingCategory{Name: strings.ToLower(string(key.action))}
+			categories[key.action] = category
+		}
+
+		item := pricingProduct{Name: key.tld}
+		for years := 1; years <= maxPricingYears; years++ {
+			amount := formatAmount(price * float64(years))
+			item.Prices = append(item.Prices, pricingPrice{
+				Duration:     years,
+				DurationType: "YEAR",
+				Price:        amount,
+				RegularPrice: amount,
+				YourPrice:    amount,
+				Currency:     "USD",
+			})
+		}
+		category.Products = append(category.Products, item)
+	}
+
+	productType := pricingProductType{Name: "domains"}
+	for _, category := range categories {
+		sort.Slice(category.Products, func(i, j int) bool {
+			return category.Products[i].Name < category.Products[j].Name
+		})
+		productType.Categories = append(productType.Categories, *category)
+	}
+	sort.Slice(productType.Categories, func(i, j int) bool {
+		return productType.Categories[i].Name < productType.Categories[j].Name
+	})
+
+	return userGetPricingResult{ProductTypes: []pricingProductType{productType}}, nil
+}
@@ -0,0 +1,58 @@
+package namecheaptest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func TestUsers(t *testing.T) {
+	t.Run("get_balances", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.SetBalance(12.5)
+		server.AddDomain(Domain{Name: "domain.com", AutoRenew: true, Expires: time.Now().Add(24 * time.Hour)})
+		server.AddDomain(Domain{Name: "later.com", AutoRenew: true})
+
+		response, err := server.Client().Users.GetBalances()
+		if err != nil {
+			t.Fatal("Unable to get balances", err)
+		}
+		assert.Equal(t, "12.50", *response.UserGetBalancesResult.AvailableBalance)
+		assert.Equal(t, "14.28", *response.UserGetBalancesResult.FundsRequiredForAutoRenew)
+	})
+
+	t.Run("get_pricing", func(t *testing.T) {
+		server := NewServer()
+		defer server.Close()
+
+		server.SetPrice(namecheap.ActionNameRegister, "com", 9)
+		client := server.Client()
+
+		action := namecheap.ActionNameRegister
+		product := namecheap.ProductNameCom
+		response, err := client.Users.GetPricing(&namecheap.GetPricingArgs{
+			ProductType: namecheap.ProductTypeDomain,
+			ActionName:  &action,
+			ProductName: &product,
+		})
+		if err != nil {
+			t.Fatal("Unable to get pricing", err)
+		}
+
+		categories := *(*response.UserGetPricingResult.ProductTypes)[0].ProductCategories
+		assert.Len(t, categories, 1)
+		assert.Equal(t, "register", *categories[0].Name)
+
+		prices := *(*categories[0].Products)[0].Prices
+		assert.Len(t, prices, 10)
+		assert.Equal(t, "18.00", *prices[1].Price)
+
+		_, err = client.Users.GetPricing(&namecheap.GetPricingArgs{ProductType: namecheap.ProductTypeSSLCertificate})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,231 @@
+// Package otelnamecheap instruments the Namecheap API client with OpenTelemetry.
+//
+// It is a separate module, so the namecheap package doesn't depend on OpenTelemetry:
+//
+//	client := namecheap.NewClient(options, otelnamecheap.Instrumentation())
+//
+// Every command creates a client span named after the command, e.g. namecheap.domains.dns.setHosts,
+// with the SLD and TLD of the domain, the retry count, the first Namecheap error number and the
+// execution time reported by the API. The calls, errors, throttled attempts and the latency are
+// recorded by the metrics listed in the constants below.
+package otelnamecheap
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// ScopeName is the instrumentation scope of the tracer and the meter
+const ScopeName = "github.com/namecheap/go-namecheap-sdk/v2/otelnamecheap"
+
+// Names of the recorded metrics
+const (
+	// MetricCalls counts the commands sent, retries excluded
+	MetricCalls = "namecheap.client.calls"
+	// MetricErrors counts the commands which failed after their last attempt
+	MetricErrors = "namecheap.client.errors"
+	// MetricThrottles counts the attempts rejected by the API rate limits
+	MetricThrottles = "namecheap.client.throttles"
+	// MetricDuration records the duration of the commands in seconds, retries included
+	MetricDuration = "namecheap.client.duration"
+)
+
+// Attributes of the spans and the metrics
+const (
+	AttributeCommand       = attribute.Key("namecheap.command")
+	AttributeSLD           = attribute.Key("namecheap.sld")
+	AttributeTLD           = attribute.Key("namecheap.tld")
+	AttributeErrorNumber   = attribute.Key("namecheap.error_number")
+	AttributeRetryCount    = attribute.Key("namecheap.retry_count")
+	AttributeExecutionTime = attribute.Key("namecheap.execution_time")
+	AttributeStatusCode    = attribute.Key("http.response.status_code")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the instrumentation
+type Option func(*config)
+
+// WithTracerProvider sets the provider of the tracer, the global provider is used by default
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the provider of the meter, the global provider is used by default
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// Instrumentation returns the client option tracing and measuring every command
+func Instrumentation(opts ...Option) namecheap.Option {
+	return namecheap.WithRequestHooks(Hooks(opts...))
+}
+
+// Hooks returns the request hooks tracing and measuring every command, see Instrumentation
+func Hooks(opts ...Option) namecheap.RequestHooks {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	i := &instrumentation{
+		tracer: cfg.tracerProvider.Tracer(ScopeName),
+	}
+	i.createInstruments(cfg.meterProvider.Meter(ScopeName))
+
+	return namecheap.RequestHooks{
+		Start:  i.start,
+		After:  i.after,
+		Finish: i.finish,
+	}
+}
+
+type instrumentation struct {
+	tracer    trace.Tracer
+	calls     metric.Int64Counter
+	errors    metric.Int64Counter
+	throttles metric.Int64Counter
+	duration  metric.Float64Histogram
+}
+
+// createInstruments creates the metric instruments, the failures are reported to the
+// OpenTelemetry error handler and leave no-op instruments behind
+func (i *instrumentation) createInstruments(meter metric.Meter) {
+	var err error
+
+	i.calls, err = meter.Int64Counter(MetricCalls, metric.WithUnit("{call}"),
+		metric.WithDescription("Number of Namecheap API commands sent, retries excluded"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	i.errors, err = meter.Int64Counter(MetricErrors, metric.WithUnit("{call}"),
+		metric.WithDescription("Number of Namecheap API commands which failed"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	i.throttles, err = meter.Int64Counter(MetricThrottles, metric.WithUnit("{attempt}"),
+		metric.WithDescription("Number of attempts rejected by the Namecheap API rate limits"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	i.duration, err = meter.Float64Histogram(MetricDuration, metric.WithUnit("s"),
+		metric.WithDescription("Duration of the Namecheap API commands, retries included"))
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+func (i *instrumentation) start(ctx context.Context, info namecheap.RequestInfo) context.Context {
+	attrs := append([]attribute.KeyValue{AttributeCommand.String(info.Command)}, domainAttributes(info.Params)...)
+
+	ctx, _ = i.tracer.Start(ctx, info.Command,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	return ctx
+}
+
+// after records the throttled attempts and adds an event for every failed attempt which is retried
+func (i *instrumentation) after(ctx context.Context, info namecheap.ResponseInfo) {
+	attempt := namecheap.RetryAttempt{Command: info.Command, Attempt: info.Attempt, StatusCode: info.StatusCode, Err: info.Err}
+	if attempt.Throttled() && i.throttles != nil {
+		i.throttles.Add(ctx, 1, metric.WithAttributes(AttributeCommand.String(info.Command)))
+	}
+
+	if info.Err == nil {
+		return
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.Int("namecheap.attempt", info.Attempt),
+		AttributeStatusCode.Int(info.StatusCode),
+	}
+	if len(info.ErrorNumbers) > 0 {
+		attrs = append(attrs, AttributeErrorNumber.String(info.ErrorNumbers[0]))
+	}
+	trace.SpanFromContext(ctx).AddEvent("namecheap.attempt_failed", trace.WithAttributes(attrs...))
+}
+
+func (i *instrumentation) finish(ctx context.Context, info namecheap.ResponseInfo) {
+	span := trace.SpanFromContext(ctx)
+
+	spanAttrs := []attribute.KeyValue{
+		AttributeRetryCount.Int(max(info.Attempt-1, 0)),
+	}
+	if info.StatusCode != 0 {
+		spanAttrs = append(spanAttrs, AttributeStatusCode.Int(info.StatusCode))
+	}
+	if info.Metadata != nil {
+		spanAttrs = append(spanAttrs, AttributeExecutionTime.Float64(info.Metadata.ExecutionTime))
+	}
+
+	metricAttrs := []attribute.KeyValue{AttributeCommand.String(info.Command)}
+
+	if info.Err != nil {
+		errorAttrs := metricAttrs
+		if len(info.ErrorNumbers) > 0 {
+			spanAttrs = append(spanAttrs, AttributeErrorNumber.String(info.ErrorNumbers[0]))
+			errorAttrs = append(errorAttrs, AttributeErrorNumber.String(info.ErrorNumbers[0]))
+		}
+
+		span.RecordError(info.Err)
+		span.SetStatus(codes.Error, info.Err.Error())
+
+		if i.errors != nil {
+			i.errors.Add(ctx, 1, metric.WithAttributes(errorAttrs...))
+		}
+	}
+
+	span.SetAttributes(spanAttrs...)
+	span.End()
+
+	if i.calls != nil {
+		i.calls.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+	}
+	if i.duration != nil {
+		i.duration.Record(ctx, info.Duration.Seconds(), metric.WithAttributes(metricAttrs...))
+	}
+}
+
+// domainAttributes returns the SLD and TLD of the domain the command applies to
+func domainAttributes(params map[string]string) []attribute.KeyValue {
+	sld, tld := params["SLD"], params["TLD"]
+
+	if sld == "" && tld == "" {
+		if domain := params["DomainName"]; domain != "" {
+			if parsed, err := namecheap.ParseDomain(domain); err == nil {
+				sld, tld = parsed.SLD, parsed.TLD
+			} else if dot := strings.Index(domain, "."); dot > 0 {
+				sld, tld = domain[:dot], domain[dot+1:]
+			}
+		}
+	}
+
+	if sld == "" && tld == "" {
+		return nil
+	}
+
+	return []attribute.KeyValue{AttributeSLD.String(strings.ToLower(sld)), AttributeTLD.String(strings.ToLower(tld))}
+}
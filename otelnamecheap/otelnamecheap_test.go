@@ -0,0 +1,148 @@
+package otelnamecheap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheaptest"
+)
+
+func setupInstrumentedClient(t *testing.T) (*namecheaptest.Server, *namecheap.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	server := namecheaptest.NewServer()
+	t.Cleanup(server.Close)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client := server.Client(Instrumentation(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	))
+
+	return server, client, spans, reader
+}
+
+func collectSums(t *testing.T, reader *sdkmetric.ManualReader) map[string][]metricdata.DataPoint[int64] {
+	t.Helper()
+
+	var data metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &data); err != nil {
+		t.Fatal("Unable to collect metrics", err)
+	}
+
+	sums := map[string][]metricdata.DataPoint[int64]{}
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				sums[m.Name] = sum.DataPoints
+			}
+			if histogram, ok := m.Data.(metricdata.Histogram[float64]); ok {
+				for _, point := range histogram.DataPoints {
+					sums[m.Name] = append(sums[m.Name], metricdata.DataPoint[int64]{Attributes: point.Attributes, Value: int64(point.Count)})
+				}
+			}
+		}
+	}
+
+	return sums
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestInstrumentation(t *testing.T) {
+	t.Run("successful_command", func(t *testing.T) {
+		server, client, spans, reader := setupInstrumentedClient(t)
+		server.AddDomain(namecheaptest.Domain{Name: "domain.co.uk"})
+		server.ThrottleNext(1)
+
+		_, err := client.DomainsDNS.GetHosts("domain.co.uk")
+		if err != nil {
+			t.Fatal("Unable to get hosts", err)
+		}
+
+		ended := spans.Ended()
+		assert.Len(t, ended, 1)
+
+		span := ended[0]
+		assert.Equal(t, "namecheap.domains.dns.getHosts", span.Name())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+		assert.Equal(t, codes.Unset, span.Status().Code)
+
+		sld, _ := spanAttribute(span, AttributeSLD)
+		assert.Equal(t, "domain", sld.AsString())
+		tld, _ := spanAttribute(span, AttributeTLD)
+		assert.Equal(t, "co.uk", tld.AsString())
+		retries, _ := spanAttribute(span, AttributeRetryCount)
+		assert.Equal(t, int64(1), retries.AsInt64())
+		executionTime, ok := spanAttribute(span, AttributeExecutionTime)
+		assert.True(t, ok)
+		assert.Equal(t, 0.001, executionTime.AsFloat64())
+		_, ok = spanAttribute(span, AttributeErrorNumber)
+		assert.False(t, ok)
+
+		assert.Len(t, span.Events(), 1)
+		assert.Equal(t, "namecheap.attempt_failed", span.Events()[0].Name)
+
+		sums := collectSums(t, reader)
+		assert.Equal(t, int64(1), sums[MetricCalls][0].Value)
+		assert.Equal(t, int64(1), sums[MetricThrottles][0].Value)
+		assert.Equal(t, int64(1), sums[MetricDuration][0].Value)
+		assert.Empty(t, sums[MetricErrors])
+	})
+
+	t.Run("failed_command", func(t *testing.T) {
+		_, client, spans, reader := setupInstrumentedClient(t)
+
+		_, err := client.Domains.GetInfo("missing.com")
+		assert.True(t, namecheap.IsDomainNotFound(err))
+
+		span := spans.Ended()[0]
+		assert.Equal(t, "namecheap.domains.getInfo", span.Name())
+		assert.Equal(t, codes.Error, span.Status().Code)
+
+		number, _ := spanAttribute(span, AttributeErrorNumber)
+		assert.Equal(t, namecheap.ErrorNumberDomainNotFound, number.AsString())
+		sld, _ := spanAttribute(span, AttributeSLD)
+		assert.Equal(t, "missing", sld.AsString())
+		retries, _ := spanAttribute(span, AttributeRetryCount)
+		assert.Equal(t, int64(0), retries.AsInt64())
+
+		sums := collectSums(t, reader)
+		assert.Len(t, sums[MetricErrors], 1)
+		number, _ = sums[MetricErrors][0].Attributes.Value(AttributeErrorNumber)
+		assert.Equal(t, namecheap.ErrorNumberDomainNotFound, number.AsString())
+	})
+
+	t.Run("parent_span", func(t *testing.T) {
+		_, client, spans, _ := setupInstrumentedClient(t)
+
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+		ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+
+		_, _ = client.Users.GetBalancesContext(ctx)
+		parent.End()
+
+		ended := spans.Ended()
+		assert.Len(t, ended, 2)
+		assert.Equal(t, parent.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	})
+}
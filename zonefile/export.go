@@ -0,0 +1,128 @@
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// maxCharacterString is the maximum length of a single TXT character string
+const maxCharacterString = 255
+
+// ExportOptions configures Export
+type ExportOptions struct {
+	// TypeMapping renders the Namecheap specific record types URL, URL301, FRAME, ALIAS and MXE
+	// as another record type, e.g. {"ALIAS": "CNAME"} or {"ALIAS": "ANAME"}.
+	// Unmapped types are written as annotated comments which Parse reads back.
+	TypeMapping map[string]string
+}
+
+// Export writes the host records returned by DomainsDNSService.GetHosts as a BIND zone file
+func Export(w io.Writer, result *namecheap.DomainDNSGetHostsResult, options *ExportOptions) error {
+	if result == nil || result.Domain == nil {
+		return fmt.Errorf("DomainDNSGetHostsResult with Domain is required")
+	}
+
+	if options == nil {
+		options = &ExportOptions{}
+	}
+
+	domain := strings.TrimSuffix(strings.ToLower(*result.Domain), ".")
+
+	out := bufio.NewWriter(w)
+
+	_, _ = fmt.Fprintf(out, "; Namecheap host records of %s\n", domain)
+	if result.EmailType != nil && *result.EmailType != "" {
+		_, _ = fmt.Fprintf(out, "%s %s%s\n", annotationPrefix, emailTypeAnnotation, *result.EmailType)
+	}
+	_, _ = fmt.Fprintf(out, "$ORIGIN %s.\n", domain)
+
+	if result.Hosts != nil {
+		for _, host := range *result.Hosts {
+			line, err := formatRecord(host.HostRecord(), options)
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintln(out, line)
+		}
+	}
+
+	return out.Flush()
+}
+
+func formatRecord(record namecheap.DomainsDNSHostRecord, options *ExportOptions) (string, error) {
+	if record.HostName == nil || record.RecordType == nil || record.Address == nil {
+		return "", fmt.Errorf("host record without HostName, Type or Address")
+	}
+
+	recordType := strings.ToUpper(*record.RecordType)
+	annotate := false
+
+	if namecheapOnlyTypes[recordType] {
+		if mapped, ok := options.TypeMapping[recordType]; ok {
+			recordType = strings.ToUpper(mapped)
+		} else {
+			annotate = true
+		}
+	}
+
+	ttl := namecheap.DefaultTTL
+	if record.TTL != nil && *record.TTL != 0 {
+		ttl = *record.TTL
+	}
+
+	var data string
+	switch recordType {
+	case namecheap.RecordTypeTXT:
+		data = quoteTXT(*record.Address)
+	case namecheap.RecordTypeMX:
+		mxPref := namecheap.DefaultMXPref
+		if record.MXPref != nil {
+			mxPref = int(*record.MXPref)
+		}
+		data = strconv.Itoa(mxPref) + " " + absoluteName(*record.Address)
+	case namecheap.RecordTypeURL, namecheap.RecordTypeURL301, namecheap.RecordTypeFrame:
+		data = quoteString(*record.Address)
+	case namecheap.RecordTypeCNAME, namecheap.RecordTypeNS, namecheap.RecordTypeAlias, "ANAME":
+		data = absoluteName(*record.Address)
+	default:
+		data = *record.Address
+	}
+
+	line := fmt.Sprintf("%s\t%d\tIN\t%s\t%s", *record.HostName, ttl, recordType, data)
+	if annotate {
+		line = annotationPrefix + " " + line
+	}
+
+	return line, nil
+}
+
+// absoluteName adds the trailing dot to the host names which Namecheap stores without it
+func absoluteName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// quoteTXT quotes the TXT value, splitting it into character strings of at most 255 bytes
+func quoteTXT(value string) string {
+	var chunks []string
+
+	for len(value) > maxCharacterString {
+		chunks = append(chunks, quoteString(value[:maxCharacterString]))
+		value = value[maxCharacterString:]
+	}
+	chunks = append(chunks, quoteString(value))
+
+	return strings.Join(chunks, " ")
+}
+
+func quoteString(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(value) + `"`
+}
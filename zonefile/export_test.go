@@ -0,0 +1,123 @@
+package zonefile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func detailedRecord(name, recordType, address string, mxPref, ttl int) namecheap.DomainsDNSHostRecordDetailed {
+	return namecheap.DomainsDNSHostRecordDetailed{
+		Name:    namecheap.String(name),
+		Type:    namecheap.String(recordType),
+		Address: namecheap.String(address),
+		MXPref:  namecheap.Int(mxPref),
+		TTL:     namecheap.Int(ttl),
+	}
+}
+
+func exportTestResult() *namecheap.DomainDNSGetHostsResult {
+	return &namecheap.DomainDNSGetHostsResult{
+		Domain:    namecheap.String("domain.net"),
+		EmailType: namecheap.String("MX"),
+		Hosts: &[]namecheap.DomainsDNSHostRecordDetailed{
+			detailedRecord("@", "A", "10.0.0.1", 10, 1800),
+			detailedRecord("www", "CNAME", "domain.net.", 10, 1800),
+			detailedRecord("@", "TXT", `v=spf1 include:"mail" -all`, 10, 300),
+			detailedRecord("mail", "MX", "mx.domain.net", 20, 1800),
+			detailedRecord("@", "CAA", `0 issue "letsencrypt.org"`, 10, 1800),
+			detailedRecord("blog", "URL301", "http://domain.net/blog", 10, 1800),
+			detailedRecord("@", "ALIAS", "lb.example.com", 10, 300),
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	t.Run("annotated", func(t *testing.T) {
+		var out strings.Builder
+
+		err := Export(&out, exportTestResult(), nil)
+		if err != nil {
+			t.Fatal("Unable to export", err)
+		}
+
+		assert.Equal(t, `; Namecheap host records of domain.net
+;@namecheap EmailType=MX
+$ORIGIN domain.net.
+@	1800	IN	A	10.0.0.1
+www	1800	IN	CNAME	domain.net.
+@	300	IN	TXT	"v=spf1 include:\"mail\" -all"
+mail	1800	IN	MX	20 mx.domain.net.
+@	1800	IN	CAA	0 issue "letsencrypt.org"
+;@namecheap blog	1800	IN	URL301	"http://domain.net/blog"
+;@namecheap @	300	IN	ALIAS	lb.example.com.
+`, out.String())
+	})
+
+	t.Run("type_mapping", func(t *testing.T) {
+		var out strings.Builder
+
+		err := Export(&out, exportTestResult(), &ExportOptions{TypeMapping: map[string]string{"ALIAS": "ANAME", "URL301": "TXT"}})
+		if err != nil {
+			t.Fatal("Unable to export", err)
+		}
+
+		assert.Contains(t, out.String(), "\nblog\t1800\tIN\tTXT\t\"http://domain.net/blog\"\n")
+		assert.Contains(t, out.String(), "\n@\t300\tIN\tANAME\tlb.example.com.\n")
+		assert.NotContains(t, out.String(), ";@namecheap @")
+	})
+
+	t.Run("long_txt", func(t *testing.T) {
+		var out strings.Builder
+
+		value := strings.Repeat("a", 300)
+		err := Export(&out, &namecheap.DomainDNSGetHostsResult{
+			Domain: namecheap.String("domain.net"),
+			Hosts:  &[]namecheap.DomainsDNSHostRecordDetailed{detailedRecord("dkim", "TXT", value, 10, 1800)},
+		}, nil)
+		if err != nil {
+			t.Fatal("Unable to export", err)
+		}
+
+		assert.Contains(t, out.String(), `"`+strings.Repeat("a", 255)+`" "`+strings.Repeat("a", 45)+`"`)
+		assert.NotContains(t, out.String(), "EmailType")
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		var out strings.Builder
+
+		err := Export(&out, exportTestResult(), nil)
+		if err != nil {
+			t.Fatal("Unable to export", err)
+		}
+
+		zone, err := Parse(strings.NewReader(out.String()), nil)
+		if err != nil {
+			t.Fatal("Unable to parse", err)
+		}
+
+		assert.Equal(t, "domain.net", zone.Domain)
+		assert.Equal(t, "MX", *zone.EmailType)
+		assert.Empty(t, zone.Unsupported)
+		assert.Empty(t, zone.Adjusted)
+
+		var expected []namecheap.DomainsDNSHostRecord
+		for _, host := range *exportTestResult().Hosts {
+			record := host.HostRecord()
+			switch *record.RecordType {
+			case "MX", "ALIAS":
+				record.Address = namecheap.String(*record.Address + ".")
+			}
+			expected = append(expected, record)
+		}
+		assert.Equal(t, expected, zone.Records)
+	})
+
+	t.Run("missing_domain", func(t *testing.T) {
+		err := Export(&strings.Builder{}, &namecheap.DomainDNSGetHostsResult{}, nil)
+		assert.EqualError(t, err, "DomainDNSGetHostsResult with Domain is required")
+	})
+}
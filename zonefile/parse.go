@@ -0,0 +1,514 @@
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// ParseOptions configures Parse
+type ParseOptions struct {
+	// Origin is the domain of the zone. It is required when the zone file has no $ORIGIN directive.
+	Origin string
+	// DefaultTTL is used for the records without TTL when the zone file has no $TTL directive.
+	// When zero, such records are left without TTL and Namecheap applies its default.
+	DefaultTTL int
+}
+
+type field struct {
+	text   string
+	quoted bool
+}
+
+type logicalLine struct {
+	number       int
+	leadingBlank bool
+	annotation   bool
+	fields       []field
+}
+
+// Parse reads a BIND zone file into Namecheap host records.
+// Records Namecheap cannot represent, like SOA or SRV, are reported in Zone.Unsupported.
+func Parse(r io.Reader, options *ParseOptions) (*Zone, error) {
+	if options == nil {
+		options = &ParseOptions{}
+	}
+
+	lines, err := readLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		zone:       &Zone{Domain: normalizeName(options.Origin)},
+		origin:     normalizeName(options.Origin),
+		defaultTTL: options.DefaultTTL,
+	}
+
+	for _, line := range lines {
+		if err := p.parseLine(line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+	}
+
+	if p.zone.Domain == "" {
+		return nil, fmt.Errorf("origin of the zone is unknown, set ParseOptions.Origin or add an $ORIGIN directive")
+	}
+
+	if p.zone.EmailType == nil {
+		for _, record := range p.zone.Records {
+			switch *record.RecordType {
+			case namecheap.RecordTypeMX:
+				p.zone.EmailType = namecheap.String(namecheap.EmailTypeMX)
+			case namecheap.RecordTypeMXE:
+				p.zone.EmailType = namecheap.String(namecheap.EmailTypeMXE)
+			}
+		}
+	}
+
+	return p.zone, nil
+}
+
+type parser struct {
+	zone       *Zone
+	origin     string
+	defaultTTL int
+	lastOwner  string
+	lastTTL    int
+}
+
+func (p *parser) parseLine(line logicalLine) error {
+	fields := line.fields
+
+	if line.annotation && len(fields) == 1 && strings.HasPrefix(fields[0].text, emailTypeAnnotation) {
+		p.zone.EmailType = namecheap.String(strings.TrimPrefix(fields[0].text, emailTypeAnnotation))
+		return nil
+	}
+
+	if !line.leadingBlank && strings.HasPrefix(fields[0].text, "$") {
+		return p.parseDirective(line)
+	}
+
+	if p.origin == "" {
+		return fmt.Errorf("record before the origin of the zone is known")
+	}
+
+	owner := p.lastOwner
+	if !line.leadingBlank {
+		owner = p.absolute(fields[0].text)
+		fields = fields[1:]
+	}
+	if owner == "" {
+		return fmt.Errorf("record without owner name")
+	}
+	p.lastOwner = owner
+
+	ttl := 0
+	class := "IN"
+	for i := 0; i < 2 && len(fields) > 0; i++ {
+		if value, err := parseTTL(fields[0].text); err == nil && ttl == 0 {
+			ttl = value
+			fields = fields[1:]
+		} else if isClass(fields[0].text) {
+			class = strings.ToUpper(fields[0].text)
+			fields = fields[1:]
+		}
+	}
+
+	if len(fields) == 0 {
+		return fmt.Errorf("record without type")
+	}
+
+	recordType := strings.ToUpper(fields[0].text)
+	data := fields[1:]
+	text := recordText(owner, recordType, data)
+
+	// records without TTL use the $TTL directive, or the last explicit TTL as in RFC 1035
+	if ttl != 0 {
+		p.lastTTL = ttl
+	} else if p.defaultTTL != 0 {
+		ttl = p.defaultTTL
+	} else {
+		ttl = p.lastTTL
+	}
+
+	if class != "IN" {
+		p.unsupported(line, text, fmt.Sprintf("class %s is not supported", class))
+		return nil
+	}
+
+	hostName, ok := p.hostName(owner)
+	if !ok {
+		p.unsupported(line, text, fmt.Sprintf("owner is outside of the zone %s", p.zone.Domain))
+		return nil
+	}
+
+	record, reason := p.record(hostName, recordType, data)
+	if reason != "" {
+		p.unsupported(line, text, reason)
+		return nil
+	}
+
+	if ttl != 0 {
+		if ttl < namecheap.MinTTL || ttl > namecheap.MaxTTL {
+			adjusted := min(max(ttl, namecheap.MinTTL), namecheap.MaxTTL)
+			p.zone.Adjusted = append(p.zone.Adjusted, Issue{
+				Line:   line.number,
+				Record: text,
+				Reason: fmt.Sprintf("TTL %d changed to %d, allowed values are between %d and %d", ttl, adjusted, namecheap.MinTTL, namecheap.MaxTTL),
+			})
+			ttl = adjusted
+		}
+		record.TTL = namecheap.Int(ttl)
+	}
+
+	p.zone.Records = append(p.zone.Records, record)
+
+	return nil
+}
+
+func (p *parser) parseDirective(line logicalLine) error {
+	directive := strings.ToUpper(line.fields[0].text)
+
+	switch directive {
+	case "$ORIGIN":
+		if len(line.fields) < 2 {
+			return fmt.Errorf("$ORIGIN without domain")
+		}
+		p.origin = p.absolute(line.fields[1].text)
+		if p.zone.Domain == "" {
+			p.zone.Domain = p.origin
+		}
+	case "$TTL":
+		if len(line.fields) < 2 {
+			return fmt.Errorf("$TTL without value")
+		}
+		ttl, err := parseTTL(line.fields[1].text)
+		if err != nil {
+			return err
+		}
+		p.defaultTTL = ttl
+	default:
+		return fmt.Errorf("directive %s is not supported", directive)
+	}
+
+	return nil
+}
+
+// record converts the record data, it returns the reason when Namecheap cannot represent the record
+func (p *parser) record(hostName, recordType string, data []field) (namecheap.DomainsDNSHostRecord, string) {
+	record := namecheap.DomainsDNSHostRecord{
+		HostName:   namecheap.String(hostName),
+		RecordType: namecheap.String(recordType),
+	}
+
+	if len(data) == 0 {
+		return record, "record without data"
+	}
+
+	switch recordType {
+	case namecheap.RecordTypeA, namecheap.RecordTypeAAAA:
+		ip := net.ParseIP(data[0].text)
+		if ip == nil || (ip.To4() != nil) != (recordType == namecheap.RecordTypeA) {
+			return record, fmt.Sprintf("invalid %s address %s", recordType, data[0].text)
+		}
+		record.Address = namecheap.String(data[0].text)
+
+	case namecheap.RecordTypeNS:
+		if hostName == "@" {
+			return record, "nameservers of the domain are set with DomainsDNSService.SetCustom"
+		}
+		record.Address = namecheap.String(p.absolute(data[0].text) + ".")
+
+	case namecheap.RecordTypeCNAME, namecheap.RecordTypeAlias:
+		record.Address = namecheap.String(p.absolute(data[0].text) + ".")
+
+	case namecheap.RecordTypeMX:
+		if len(data) < 2 {
+			return record, "MX record without preference or exchange"
+		}
+		preference, err := strconv.ParseUint(data[0].text, 10, 8)
+		if err != nil {
+			return record, fmt.Sprintf("MX preference %s is out of the range 0..255", data[0].text)
+		}
+		mxPref := uint8(preference)
+		record.MXPref = &mxPref
+		record.Address = namecheap.String(p.absolute(data[1].text) + ".")
+
+	case namecheap.RecordTypeTXT:
+		var value strings.Builder
+		for _, part := range data {
+			value.WriteString(part.text)
+		}
+		record.Address = namecheap.String(value.String())
+
+	case namecheap.RecordTypeCAA:
+		if len(data) < 3 {
+			return record, "CAA record without flags, tag or value"
+		}
+		record.Address = namecheap.String(data[0].text + " " + strings.ToLower(data[1].text) + " " + quoteString(data[2].text))
+
+	case namecheap.RecordTypeURL, namecheap.RecordTypeURL301, namecheap.RecordTypeFrame, namecheap.RecordTypeMXE:
+		record.Address = namecheap.String(data[0].text)
+
+	case "SOA":
+		return record, "SOA record is managed by Namecheap"
+
+	default:
+		return record, fmt.Sprintf("record type %s is not supported by Namecheap", recordType)
+	}
+
+	return record, ""
+}
+
+func (p *parser) unsupported(line logicalLine, text, reason string) {
+	p.zone.Unsupported = append(p.zone.Unsupported, Issue{Line: line.number, Record: text, Reason: reason})
+}
+
+// absolute returns the name relative to the current origin as an absolute name without the trailing dot
+func (p *parser) absolute(name string) string {
+	if name == "@" {
+		return p.origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return normalizeName(name)
+	}
+	if p.origin == "" {
+		return normalizeName(name)
+	}
+	return normalizeName(name + "." + p.origin)
+}
+
+// hostName returns the Namecheap host name of the absolute owner name
+func (p *parser) hostName(owner string) (string, bool) {
+	if owner == p.zone.Domain {
+		return "@", true
+	}
+	if strings.HasSuffix(owner, "."+p.zone.Domain) {
+		return strings.TrimSuffix(owner, "."+p.zone.Domain), true
+	}
+	return "", false
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+func isClass(value string) bool {
+	switch strings.ToUpper(value) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// parseTTL parses a TTL in seconds or with the BIND units, e.g. 1h30m
+func parseTTL(value string) (int, error) {
+	if value == "" {
+		return 0, fmt.Errorf("invalid TTL value: %s", value)
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return seconds, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	total, number, digits := 0, 0, 0
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= '0' && c <= '9':
+			number = number*10 + int(c-'0')
+			digits++
+		case units[toLower(c)] != 0 && digits > 0:
+			total += number * units[toLower(c)]
+			number, digits = 0, 0
+		default:
+			return 0, fmt.Errorf("invalid TTL value: %s", value)
+		}
+	}
+
+	if digits > 0 {
+		return 0, fmt.Errorf("invalid TTL value: %s", value)
+	}
+
+	return total, nil
+}
+
+func toLower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+func recordText(owner, recordType string, data []field) string {
+	parts := []string{owner + ".", recordType}
+	for _, f := range data {
+		if f.quoted {
+			parts = append(parts, quoteString(f.text))
+		} else {
+			parts = append(parts, f.text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// readLines splits the zone file into logical lines, joining the lines within parentheses
+// and dropping the comments except for the Namecheap annotations
+func readLines(r io.Reader) ([]logicalLine, error) {
+	var lines []logicalLine
+	var current *logicalLine
+	depth := 0
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := scanner.Text()
+
+		if depth == 0 && strings.HasPrefix(text, annotationPrefix+" ") {
+			fields, _, err := tokenize(strings.TrimPrefix(text, annotationPrefix+" "), 0)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", number, err)
+			}
+			if len(fields) > 0 {
+				lines = append(lines, logicalLine{number: number, annotation: true, fields: fields})
+			}
+			continue
+		}
+
+		if depth == 0 {
+			current = &logicalLine{number: number, leadingBlank: text != "" && (text[0] == ' ' || text[0] == '\t')}
+		}
+
+		fields, newDepth, err := tokenize(text, depth)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", number, err)
+		}
+		depth = newDepth
+		current.fields = append(current.fields, fields...)
+
+		if depth == 0 && len(current.fields) > 0 {
+			lines = append(lines, *current)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+
+	return lines, nil
+}
+
+// tokenize splits a line into fields, it returns the parentheses depth at the end of the line
+func tokenize(text string, depth int) ([]field, int, error) {
+	var fields []field
+	var token strings.Builder
+	inToken := false
+
+	flush := func() {
+		if inToken {
+			fields = append(fields, field{text: token.String()})
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		switch {
+		case c == ';':
+			flush()
+			return fields, depth, nil
+
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+
+		case c == '(':
+			flush()
+			depth++
+
+		case c == ')':
+			flush()
+			if depth == 0 {
+				return nil, 0, fmt.Errorf("unbalanced parentheses")
+			}
+			depth--
+
+		case c == '"':
+			flush()
+			value, end, err := readQuoted(text, i+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			fields = append(fields, field{text: value, quoted: true})
+			i = end
+
+		case c == '\\' && i+1 < len(text):
+			value, end := readEscape(text, i+1)
+			token.WriteByte(value)
+			inToken = true
+			i = end
+
+		default:
+			token.WriteByte(c)
+			inToken = true
+		}
+	}
+
+	flush()
+
+	return fields, depth, nil
+}
+
+// readQuoted reads a quoted string starting after the opening quote, it returns the index of the closing quote
+func readQuoted(text string, start int) (string, int, error) {
+	var value strings.Builder
+
+	for i := start; i < len(text); i++ {
+		switch text[i] {
+		case '"':
+			return value.String(), i, nil
+		case '\\':
+			if i+1 < len(text) {
+				c, end := readEscape(text, i+1)
+				value.WriteByte(c)
+				i = end
+				continue
+			}
+		}
+		value.WriteByte(text[i])
+	}
+
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+// readEscape reads the escape sequence starting after the backslash, \X or \DDD,
+// it returns the escaped byte and the index of the last byte of the sequence
+func readEscape(text string, start int) (byte, int) {
+	if start+2 < len(text) && isDigit(text[start]) && isDigit(text[start+1]) && isDigit(text[start+2]) {
+		value, _ := strconv.Atoi(text[start : start+3])
+		if value <= 255 {
+			return byte(value), start + 2
+		}
+	}
+	return text[start], start
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,122 @@
+package zonefile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("bind_zone", func(t *testing.T) {
+		zone, err := Parse(strings.NewReader(`
+$ORIGIN domain.com.
+$TTL 1h
+@	IN	SOA	ns1.provider.net. hostmaster.domain.com. (
+		2024010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		300 )      ; minimum
+@		IN	NS	ns1.provider.net.
+		IN	A	10.0.0.1
+www	300	IN	CNAME	@
+api		IN	AAAA	2001:db8::1
+@	86400	IN	MX	10 mail
+		IN	MX	20 mail.backup.net.
+@		IN	TXT	"v=spf1 " "-all" ; split string
+_sip._tcp	IN	SRV	10 60 5060 sip.domain.com.
+sub		IN	NS	ns.other.net.
+other.net.	IN	A	10.0.0.2
+@		IN	CAA	0 ISSUE "letsencrypt.org"
+`), nil)
+		if err != nil {
+			t.Fatal("Unable to parse", err)
+		}
+
+		mx10, mx20 := uint8(10), uint8(20)
+
+		assert.Equal(t, "domain.com", zone.Domain)
+		assert.Equal(t, "MX", *zone.EmailType)
+		assert.Equal(t, []namecheap.DomainsDNSHostRecord{
+			{HostName: namecheap.String("@"), RecordType: namecheap.String("A"), Address: namecheap.String("10.0.0.1"), TTL: namecheap.Int(3600)},
+			{HostName: namecheap.String("www"), RecordType: namecheap.String("CNAME"), Address: namecheap.String("domain.com."), TTL: namecheap.Int(300)},
+			{HostName: namecheap.String("api"), RecordType: namecheap.String("AAAA"), Address: namecheap.String("2001:db8::1"), TTL: namecheap.Int(3600)},
+			{HostName: namecheap.String("@"), RecordType: namecheap.String("MX"), Address: namecheap.String("mail.domain.com."), MXPref: &mx10, TTL: namecheap.Int(60000)},
+			{HostName: namecheap.String("@"), RecordType: namecheap.String("MX"), Address: namecheap.String("mail.backup.net."), MXPref: &mx20, TTL: namecheap.Int(3600)},
+			{HostName: namecheap.String("@"), RecordType: namecheap.String("TXT"), Address: namecheap.String("v=spf1 -all"), TTL: namecheap.Int(3600)},
+			{HostName: namecheap.String("sub"), RecordType: namecheap.String("NS"), Address: namecheap.String("ns.other.net."), TTL: namecheap.Int(3600)},
+			{HostName: namecheap.String("@"), RecordType: namecheap.String("CAA"), Address: namecheap.String(`0 issue "letsencrypt.org"`), TTL: namecheap.Int(3600)},
+		}, zone.Records)
+
+		assert.Equal(t, []Issue{
+			{Line: 4, Record: "domain.com. SOA ns1.provider.net. hostmaster.domain.com. 2024010101 7200 3600 1209600 300", Reason: "SOA record is managed by Namecheap"},
+			{Line: 10, Record: "domain.com. NS ns1.provider.net.", Reason: "nameservers of the domain are set with DomainsDNSService.SetCustom"},
+			{Line: 17, Record: "_sip._tcp.domain.com. SRV 10 60 5060 sip.domain.com.", Reason: "record type SRV is not supported by Namecheap"},
+			{Line: 19, Record: "other.net. A 10.0.0.2", Reason: "owner is outside of the zone domain.com"},
+		}, zone.Unsupported)
+
+		assert.Equal(t, []Issue{
+			{Line: 14, Record: "domain.com. MX 10 mail", Reason: "TTL 86400 changed to 60000, allowed values are between 60 and 60000"},
+		}, zone.Adjusted)
+
+		args := zone.SetHostsArgs()
+		assert.Equal(t, "domain.com", *args.Domain)
+		assert.Len(t, *args.Records, 8)
+
+		assert.Equal(t, "domain.com", zone.NamecheapZone().Domain)
+	})
+
+	t.Run("origin_option", func(t *testing.T) {
+		zone, err := Parse(strings.NewReader("www CNAME domain.com.\nftp 600 A 10.0.0.3\n"), &ParseOptions{Origin: "Domain.com."})
+		if err != nil {
+			t.Fatal("Unable to parse", err)
+		}
+
+		assert.Equal(t, "domain.com", zone.Domain)
+		assert.Nil(t, zone.EmailType)
+		assert.Nil(t, zone.Records[0].TTL)
+		assert.Equal(t, 600, *zone.Records[1].TTL)
+	})
+
+	t.Run("default_ttl_option", func(t *testing.T) {
+		zone, err := Parse(strings.NewReader("www CNAME domain.com.\n"), &ParseOptions{Origin: "domain.com", DefaultTTL: 900})
+		if err != nil {
+			t.Fatal("Unable to parse", err)
+		}
+
+		assert.Equal(t, 900, *zone.Records[0].TTL)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := Parse(strings.NewReader("www CNAME domain.com.\n"), nil)
+		assert.EqualError(t, err, "line 1: record before the origin of the zone is known")
+
+		_, err = Parse(strings.NewReader("$ORIGIN domain.com.\n$INCLUDE other.zone\n"), nil)
+		assert.EqualError(t, err, "line 2: directive $INCLUDE is not supported")
+
+		_, err = Parse(strings.NewReader("$ORIGIN domain.com.\n@ TXT \"unterminated\n"), nil)
+		assert.EqualError(t, err, "line 2: unterminated quoted string")
+
+		_, err = Parse(strings.NewReader("$ORIGIN domain.com.\n@ SOA ns. host. ( 1 2\n"), nil)
+		assert.EqualError(t, err, "line 2: unbalanced parentheses")
+
+		_, err = Parse(strings.NewReader(""), nil)
+		assert.EqualError(t, err, "origin of the zone is unknown, set ParseOptions.Origin or add an $ORIGIN directive")
+	})
+}
+
+func TestParseTTL(t *testing.T) {
+	for value, expected := range map[string]int{"300": 300, "1h": 3600, "1h30m": 5400, "2D": 172800, "1w": 604800} {
+		ttl, err := parseTTL(value)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, ttl, value)
+	}
+
+	for _, value := range []string{"", "IN", "10x", "h", "-5"} {
+		_, err := parseTTL(value)
+		assert.NotNil(t, err, value)
+	}
+}
@@ -0,0 +1,77 @@
+// Package zonefile converts Namecheap host records from and to BIND zone files (RFC 1035).
+//
+// Export renders the result of DomainsDNSService.GetHosts as a zone file. The Namecheap specific
+// record types URL, URL301, FRAME, ALIAS and MXE have no zone file equivalent, they are written as
+// annotated comments unless ExportOptions.TypeMapping maps them to another record type:
+//
+//	;@namecheap blog 1800 IN URL301 "http://domain.com/blog"
+//
+// Parse reads a zone file into records ready for DomainsDNSService.SetHosts. Annotated comments are
+// read back as records, and the records Namecheap cannot represent are reported instead of failing.
+package zonefile
+
+import (
+	"fmt"
+
+	"github.com/namecheap/go-namecheap-sdk/v2/namecheap"
+)
+
+// annotationPrefix starts the comments holding Namecheap specific records and settings
+const annotationPrefix = ";@namecheap"
+
+// emailTypeAnnotation is the annotation holding the email type of the domain
+const emailTypeAnnotation = "EmailType="
+
+// namecheapOnlyTypes are the Namecheap record types without a zone file equivalent
+var namecheapOnlyTypes = map[string]bool{
+	namecheap.RecordTypeURL:    true,
+	namecheap.RecordTypeURL301: true,
+	namecheap.RecordTypeFrame:  true,
+	namecheap.RecordTypeAlias:  true,
+	namecheap.RecordTypeMXE:    true,
+}
+
+// Zone is the content of a zone file converted to Namecheap host records
+type Zone struct {
+	// Domain is the origin of the zone without the trailing dot
+	Domain string
+	// EmailType is read from the annotation written by Export, or set to MX or MXE when the zone has such records
+	EmailType *string
+	Records   []namecheap.DomainsDNSHostRecord
+	// Unsupported lists the records left out because Namecheap cannot represent them
+	Unsupported []Issue
+	// Adjusted lists the records changed to be accepted by Namecheap, e.g. TTLs out of the allowed range
+	Adjusted []Issue
+}
+
+// Issue describes a record of the zone file which could not be converted as is
+type Issue struct {
+	// Line is the line number of the record in the zone file
+	Line   int
+	Record string
+	Reason string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("line %d: %s: %s", i.Line, i.Record, i.Reason)
+}
+
+// SetHostsArgs returns the arguments replacing the host records of the domain with the zone records
+func (z *Zone) SetHostsArgs() *namecheap.DomainsDNSSetHostsArgs {
+	records := append([]namecheap.DomainsDNSHostRecord{}, z.Records...)
+
+	return &namecheap.DomainsDNSSetHostsArgs{
+		Domain:    namecheap.String(z.Domain),
+		EmailType: z.EmailType,
+		Records:   &records,
+	}
+}
+
+// NamecheapZone returns the zone as the desired state for DomainsDNSService.PlanZone and ApplyZone
+func (z *Zone) NamecheapZone() *namecheap.Zone {
+	return &namecheap.Zone{
+		Domain:    z.Domain,
+		EmailType: z.EmailType,
+		Records:   append([]namecheap.DomainsDNSHostRecord{}, z.Records...),
+	}
+}